@@ -0,0 +1,18 @@
+package boil
+
+import "context"
+
+// WithActor modifies a context so the rows that hooks record in audit tables
+// name the actor, eg. the user that made the changes.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, ctxActor, actor)
+}
+
+// ActorFrom returns the actor of the context, if it has one
+func ActorFrom(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	actor, ok := ctx.Value(ctxActor).(string)
+	return actor, ok
+}
@@ -0,0 +1,19 @@
+package boil
+
+import (
+	"context"
+	"testing"
+)
+
+func TestActor(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := ActorFrom(context.Background()); ok {
+		t.Error("want no actor")
+	}
+
+	ctx := WithActor(context.Background(), "jane")
+	if actor, ok := ActorFrom(ctx); !ok || actor != "jane" {
+		t.Error("want actor jane, got:", actor, ok)
+	}
+}
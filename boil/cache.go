@@ -0,0 +1,192 @@
+package boil
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache stores the results of queries. The queries made with a cache TTL,
+// see qm.Cache and WithCache, are looked up in it by their SQL and args, and
+// the generated models invalidate the entries tagged with their table when
+// they write to it.
+//
+// A Cache must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key, if it's there and not expired
+	Get(key string) (interface{}, bool)
+	// Set stores value for key until ttl has passed, or until one of its
+	// tags is invalidated
+	Set(key string, value interface{}, ttl time.Duration, tags []string)
+	// Invalidate removes the values that have any of the tags
+	Invalidate(tags ...string)
+}
+
+var currentCache Cache
+
+// SetCache sets the cache that queries with a cache TTL use, nil disables
+// caching.
+func SetCache(cache Cache) {
+	currentCache = cache
+}
+
+// GetCache retrieves the global cache
+func GetCache() Cache {
+	return currentCache
+}
+
+// InvalidateCache removes the values tagged with any of the tags from the
+// global cache, if there is one.
+func InvalidateCache(tags ...string) {
+	if currentCache != nil {
+		currentCache.Invalidate(tags...)
+	}
+}
+
+// InvalidateCacheContext removes the values tagged with any of the tags from
+// the global cache like InvalidateCache. When ctx is the context of a
+// transaction run by InTx they're removed once it's committed instead, so
+// the old rows can't be cached again before the new ones are visible, and
+// not at all when it's rolled back. Transactions begun otherwise can't be
+// seen committing: the values are removed right away, and should be removed
+// again with InvalidateCache after committing.
+func InvalidateCacheContext(ctx context.Context, tags ...string) {
+	if ctx != nil {
+		if state, ok := ctx.Value(ctxTx).(*txState); ok {
+			state.invalidateCache(tags...)
+			return
+		}
+	}
+	InvalidateCache(tags...)
+}
+
+// WithCache modifies a context so that the queries run with it are cached for
+// ttl, for the queries that take no query mods like Find.
+func WithCache(ctx context.Context, ttl time.Duration) context.Context {
+	return context.WithValue(ctx, ctxCache, ttl)
+}
+
+// CacheTTLFrom returns the cache TTL of the context, if it has one
+func CacheTTLFrom(ctx context.Context) (time.Duration, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	ttl, ok := ctx.Value(ctxCache).(time.Duration)
+	return ttl, ok
+}
+
+// LRUCache is an in-memory Cache that holds up to a number of values, the
+// least recently used one is removed to make room for new ones.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	tags     map[string]map[string]struct{}
+}
+
+type lruEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+	tags    []string
+}
+
+// NewLRUCache creates an in-memory cache that holds up to capacity values.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		tags:     make(map[string]map[string]struct{}),
+	}
+}
+
+// Get implements Cache.
+func (c *LRUCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*lruEntry)
+	if !entry.expires.IsZero() && !time.Now().Before(entry.expires) {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set implements Cache, a ttl of 0 or less keeps the value until it's
+// invalidated or evicted.
+func (c *LRUCache) Set(key string, value interface{}, ttl time.Duration, tags []string) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+
+	entry := &lruEntry{key: key, value: value, tags: append([]string(nil), tags...)}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for _, tag := range entry.tags {
+		keys, ok := c.tags[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tags[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+// Invalidate implements Cache.
+func (c *LRUCache) Invalidate(tags ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, tag := range tags {
+		for key := range c.tags[tag] {
+			if elem, ok := c.entries[key]; ok {
+				c.remove(elem)
+			}
+		}
+	}
+}
+
+// Len returns the number of values in the cache, including the expired ones
+// that weren't removed yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// remove removes the entry of elem and its tags
+func (c *LRUCache) remove(elem *list.Element) {
+	entry := c.order.Remove(elem).(*lruEntry)
+	delete(c.entries, entry.key)
+	for _, tag := range entry.tags {
+		keys := c.tags[tag]
+		delete(keys, entry.key)
+		if len(keys) == 0 {
+			delete(c.tags, tag)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package boil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Parallel()
+
+	c := NewLRUCache(2)
+	c.Set("a", 1, 0, []string{"users"})
+	c.Set("b", 2, 0, []string{"users", "pets"})
+
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Error("want a to be 1, got:", v, ok)
+	}
+
+	// b is the least recently used value
+	c.Set("c", 3, 0, []string{"pets"})
+	if _, ok := c.Get("b"); ok {
+		t.Error("want b to be evicted")
+	}
+	if c.Len() != 2 {
+		t.Error("want 2 values, got:", c.Len())
+	}
+
+	c.Invalidate("pets")
+	if _, ok := c.Get("c"); ok {
+		t.Error("want c to be invalidated")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("want a to be kept")
+	}
+
+	c.Invalidate("users")
+	if c.Len() != 0 {
+		t.Error("want no values, got:", c.Len())
+	}
+	if len(c.tags) != 0 {
+		t.Error("want no tags, got:", c.tags)
+	}
+}
+
+func TestLRUCacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	c := NewLRUCache(10)
+	c.Set("a", 1, time.Nanosecond, nil)
+	c.Set("b", 2, time.Hour, nil)
+	time.Sleep(time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Error("want a to be expired")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Error("want b to be cached")
+	}
+	if c.Len() != 1 {
+		t.Error("want the expired value to be removed, got:", c.Len())
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := CacheTTLFrom(context.Background()); ok {
+		t.Error("want no ttl")
+	}
+
+	ctx := WithCache(context.Background(), time.Minute)
+	if ttl, ok := CacheTTLFrom(ctx); !ok || ttl != time.Minute {
+		t.Error("want a ttl of a minute, got:", ttl, ok)
+	}
+}
+
+func TestInvalidateCacheContext(t *testing.T) {
+	cache := NewLRUCache(10)
+	SetCache(cache)
+	defer SetCache(nil)
+
+	db := openTxTestDB(t)
+	ctx := context.Background()
+
+	cache.Set("a", 1, 0, []string{"pilots"})
+	err := InTx(ctx, db, nil, func(ctx context.Context, tx ContextExecutor) error {
+		InvalidateCacheContext(ctx, "pilots")
+		if _, ok := cache.Get("a"); !ok {
+			t.Error("want a to be kept until the transaction is committed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Get("a"); ok {
+		t.Error("want a to be invalidated after the commit")
+	}
+
+	cache.Set("a", 1, 0, []string{"pilots"})
+	_ = InTx(ctx, db, nil, func(ctx context.Context, tx ContextExecutor) error {
+		InvalidateCacheContext(ctx, "pilots")
+		return errors.New("failed")
+	})
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("want a to be kept when the transaction is rolled back")
+	}
+
+	InvalidateCacheContext(ctx, "pilots")
+	if _, ok := cache.Get("a"); ok {
+		t.Error("want a to be invalidated right away outside of a transaction")
+	}
+}
@@ -16,6 +16,7 @@ const (
 	columnsWhitelist
 	columnsGreylist
 	columnsBlacklist
+	columnsDirty
 )
 
 // Columns is a list of columns and a kind of list.
@@ -101,6 +102,21 @@ func (c Columns) IsGreylist() bool {
 	return c.Kind == columnsGreylist
 }
 
+// Dirty is a list of the columns whose values were changed since the object
+// was loaded. Update resolves it to a whitelist of those columns when the
+// object has a snapshot, anywhere else it's the same as Infer.
+func Dirty() Columns {
+	return Columns{
+		Kind: columnsDirty,
+	}
+}
+
+// IsDirty checks to see if only the changed columns should be used.
+// This method is here simply to not have to export the columns types.
+func (c Columns) IsDirty() bool {
+	return c.Kind == columnsDirty
+}
+
 // InsertColumnSet generates the set of columns to insert and return for an
 // insert statement. The return columns are used to get values that are
 // assigned within the database during the insert to keep the struct in sync
@@ -134,7 +150,7 @@ func (c Columns) InsertColumnSet(cols, defaults, noDefaults, nonZeroDefaults []s
 	case columnsNone:
 		return nil, nil
 
-	case columnsInfer:
+	case columnsInfer, columnsDirty:
 		insert := make([]string, len(noDefaults))
 		copy(insert, noDefaults)
 		insert = append(insert, nonZeroDefaults...)
@@ -182,7 +198,7 @@ func (c Columns) UpdateColumnSet(allColumns, pkeyCols []string) []string {
 	switch c.Kind {
 	case columnsNone:
 		return nil
-	case columnsInfer:
+	case columnsInfer, columnsDirty:
 		return strmangle.SetComplement(allColumns, pkeyCols)
 	case columnsWhitelist:
 		return c.Cols
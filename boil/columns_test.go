@@ -37,6 +37,13 @@ func TestColumns(t *testing.T) {
 	if len(list.Cols) != 0 {
 		t.Error("non zero length columns")
 	}
+	list = Dirty()
+	if list.Kind != columnsDirty || !list.IsDirty() {
+		t.Error(list.Kind)
+	}
+	if len(list.Cols) != 0 {
+		t.Error("non zero length columns")
+	}
 }
 
 func TestInsertColumnSet(t *testing.T) {
@@ -116,6 +123,9 @@ func TestUpdateColumnSet(t *testing.T) {
 		// Infer
 		{Columns: Infer(), Cols: []string{"a", "b"}, PKeys: []string{"a"}, Out: []string{"b"}},
 
+		// Dirty
+		{Columns: Dirty(), Cols: []string{"a", "b"}, PKeys: []string{"a"}, Out: []string{"b"}},
+
 		// Whitelist
 		{Columns: Whitelist("a"), Cols: []string{"a", "b"}, PKeys: []string{"a"}, Out: []string{"a"}},
 		{Columns: Whitelist("a", "b"), Cols: []string{"a", "b"}, PKeys: []string{"a"}, Out: []string{"a", "b"}},
@@ -7,4 +7,12 @@ const (
 	ctxSkipTimestamps
 	ctxDebug
 	ctxDebugWriter
+	ctxOperation
+	ctxPrimary
+	ctxTx
+	ctxTenant
+	ctxAllTenants
+	ctxActor
+	ctxCache
+	ctxArgColumns
 )
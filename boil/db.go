@@ -34,6 +34,26 @@ type Beginner interface {
 	Begin() (*sql.Tx, error)
 }
 
+// ConcurrentExecutor is implemented by executors that wrap others to tell if
+// they can run queries concurrently.
+type ConcurrentExecutor interface {
+	Concurrent() bool
+}
+
+// IsConcurrent checks if exec can run queries concurrently, which a database
+// handle can but a transaction or a single connection can't. Executors made
+// by WrapExecutor and RoutingExecutors can if the executors they send queries
+// to can, other executors tell by implementing ConcurrentExecutor.
+func IsConcurrent(exec Executor) bool {
+	switch e := exec.(type) {
+	case *sql.DB:
+		return true
+	case ConcurrentExecutor:
+		return e.Concurrent()
+	}
+	return false
+}
+
 // Begin a transaction with the current global database handle.
 func Begin() (Transactor, error) {
 	creator, ok := currentDB.(Beginner)
@@ -14,3 +14,29 @@ func TestGetSetDB(t *testing.T) {
 		t.Errorf("Expected GetDB to return a database handle, got nil")
 	}
 }
+
+func TestIsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	db := &sql.DB{}
+	tx := &sql.Tx{}
+
+	tests := []struct {
+		exec Executor
+		want bool
+	}{
+		{db, true},
+		{tx, false},
+		{WrapExecutor(db), true},
+		{WrapExecutor(tx), false},
+		{NewRoutingExecutor(db, db, WrapExecutor(db)), true},
+		{NewRoutingExecutor(WrapExecutor(db), db, tx), false},
+		{WrapExecutor(NewRoutingExecutor(db, db)), true},
+	}
+
+	for i, test := range tests {
+		if got := IsConcurrent(test.exec); got != test.want {
+			t.Errorf("%d) want %t, got %t", i, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Call describes a single statement run through an executor created by
+// WrapExecutor. Duration, RowsAffected and Err are only set once the next
+// function given to an Interceptor has returned.
+type Call struct {
+	// Method is the executor method that was called: Exec, Query or QueryRow
+	Method string
+	// Table and Operation are taken from the context, see WithOperation
+	Table     string
+	Operation Operation
+
+	SQL  string
+	Args []interface{}
+	// ArgColumns are the columns the args are bound to, taken from the
+	// context, see WithArgColumns
+	ArgColumns []string
+
+	Duration time.Duration
+	// RowsAffected is -1 when it's not known, which is always the
+	// case for Query and QueryRow
+	RowsAffected int64
+	Err          error
+}
+
+// Interceptor wraps a single call to an executor. It must call next to
+// continue the chain, and may modify the context passed along to it.
+// The error returned by the interceptor is returned to the caller.
+type Interceptor func(ctx context.Context, call *Call, next func(context.Context) error) error
+
+type interceptedExecutor struct {
+	exec         Executor
+	interceptors []Interceptor
+}
+
+// WrapExecutor returns an executor that runs every call to exec through
+// the interceptors, the first interceptor being the outermost one. The
+// context methods fall back to the plain ones if exec is not a
+// ContextExecutor.
+//
+// Transactions begun on the underlying database handle are not
+// intercepted, wrap them separately.
+func WrapExecutor(exec Executor, interceptors ...Interceptor) ContextExecutor {
+	return interceptedExecutor{
+		exec:         exec,
+		interceptors: append([]Interceptor(nil), interceptors...),
+	}
+}
+
+// UnwrapExecutor returns the executor given to WrapExecutor, or exec
+// itself if it was not wrapped.
+func UnwrapExecutor(exec Executor) Executor {
+	if i, ok := exec.(interceptedExecutor); ok {
+		return i.exec
+	}
+	return exec
+}
+
+// Concurrent checks if the wrapped executor can run queries concurrently
+func (i interceptedExecutor) Concurrent() bool {
+	return IsConcurrent(i.exec)
+}
+
+// Exec intercepts Exec
+func (i interceptedExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return i.ExecContext(context.Background(), query, args...)
+}
+
+// Query intercepts Query
+func (i interceptedExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return i.QueryContext(context.Background(), query, args...)
+}
+
+// QueryRow intercepts QueryRow
+func (i interceptedExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return i.QueryRowContext(context.Background(), query, args...)
+}
+
+// ExecContext intercepts ExecContext
+func (i interceptedExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	var result sql.Result
+	call := newCall(ctx, "Exec", query, args)
+	err := i.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		if c, ok := i.exec.(ContextExecutor); ok {
+			result, err = c.ExecContext(ctx, query, args...)
+		} else {
+			result, err = i.exec.Exec(query, args...)
+		}
+		if err == nil {
+			if n, rerr := result.RowsAffected(); rerr == nil {
+				call.RowsAffected = n
+			}
+		}
+		return err
+	})
+
+	return result, err
+}
+
+// QueryContext intercepts QueryContext
+func (i interceptedExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	var rows *sql.Rows
+	call := newCall(ctx, "Query", query, args)
+	err := i.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		if c, ok := i.exec.(ContextExecutor); ok {
+			rows, err = c.QueryContext(ctx, query, args...)
+		} else {
+			rows, err = i.exec.Query(query, args...)
+		}
+		return err
+	})
+
+	return rows, err
+}
+
+// QueryRowContext intercepts QueryRowContext like QueryRowResult. A *sql.Row
+// can only hold the error of a query, so when an interceptor returns another
+// error, or doesn't call next, it's returned by a query made to fail with it.
+func (i interceptedExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	row := i.QueryRowResult(ctx, query, args...)
+	if r, ok := row.(*sql.Row); ok {
+		return r
+	}
+	return sqlErrorRow(row.Err())
+}
+
+// QueryRowResult intercepts QueryRowContext, the error seen by the
+// interceptors is the one that will be returned by Scan. When an interceptor
+// returns another error, or doesn't call next, the row's Scan returns the
+// interceptor's error instead.
+func (i interceptedExecutor) QueryRowResult(ctx context.Context, query string, args ...interface{}) Row {
+	var row Row
+	call := newCall(ctx, "QueryRow", query, args)
+	err := i.intercept(ctx, call, func(ctx context.Context) error {
+		if c, ok := i.exec.(ContextExecutor); ok {
+			row = QueryRowContext(ctx, c, query, args...)
+		} else {
+			row = QueryRow(i.exec, query, args...)
+		}
+		return row.Err()
+	})
+
+	switch {
+	case row == nil && err == nil:
+		return ErrorRow(sql.ErrNoRows)
+	case row == nil:
+		return ErrorRow(err)
+	case err != nil && err != row.Err():
+		// Scanning nothing releases the connection of the row that's dropped
+		_ = row.Scan()
+		return ErrorRow(err)
+	}
+
+	return row
+}
+
+func newCall(ctx context.Context, method, query string, args []interface{}) *Call {
+	table, op := OperationFrom(ctx)
+	return &Call{
+		Method:       method,
+		Table:        table,
+		Operation:    op,
+		SQL:          query,
+		Args:         args,
+		ArgColumns:   ArgColumnsFrom(ctx),
+		RowsAffected: -1,
+	}
+}
+
+// intercept runs fn through the interceptor chain, timing fn itself
+func (i interceptedExecutor) intercept(ctx context.Context, call *Call, fn func(context.Context) error) error {
+	var next func(n int, ctx context.Context) error
+	next = func(n int, ctx context.Context) error {
+		if n == len(i.interceptors) {
+			start := time.Now()
+			call.Err = fn(ctx)
+			call.Duration = time.Since(start)
+			return call.Err
+		}
+
+		return i.interceptors[n](ctx, call, func(ctx context.Context) error {
+			return next(n+1, ctx)
+		})
+	}
+
+	return next(0, ctx)
+}
@@ -0,0 +1,132 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+)
+
+type testResult int64
+
+func (t testResult) LastInsertId() (int64, error) { return 0, nil }
+func (t testResult) RowsAffected() (int64, error) { return int64(t), nil }
+
+type testExecutor struct {
+	queried int
+}
+
+func (t *testExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return testResult(len(args)), nil
+}
+
+func (t *testExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	t.queried++
+	return nil, errors.New("query failed")
+}
+
+func (t *testExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+func TestWrapExecutor(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	var calls []Call
+	outer := func(ctx context.Context, call *Call, next func(context.Context) error) error {
+		order = append(order, "outer")
+		err := next(ctx)
+		calls = append(calls, *call)
+		return err
+	}
+	inner := func(ctx context.Context, call *Call, next func(context.Context) error) error {
+		order = append(order, "inner")
+		return next(ctx)
+	}
+
+	underlying := &testExecutor{}
+	exec := WrapExecutor(underlying, outer, inner)
+	if UnwrapExecutor(exec) != underlying {
+		t.Error("unwrap returned the wrong executor")
+	}
+
+	ctx := WithOperation(context.Background(), "pilots", DeleteOperation)
+	if _, err := exec.ExecContext(ctx, "delete from pilots where id = ?", 5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := exec.Query("select * from pilots"); err == nil {
+		t.Error("expected the query error to be returned")
+	}
+
+	if len(order) != 4 || order[0] != "outer" || order[1] != "inner" {
+		t.Errorf("interceptors ran in the wrong order: %v", order)
+	}
+	if len(calls) != 2 {
+		t.Fatal("want 2 calls, got:", len(calls))
+	}
+
+	c := calls[0]
+	if c.Method != "Exec" || c.Table != "pilots" || c.Operation != DeleteOperation {
+		t.Errorf("exec call metadata was wrong: %#v", c)
+	}
+	if c.SQL != "delete from pilots where id = ?" || len(c.Args) != 1 || c.RowsAffected != 1 || c.Err != nil {
+		t.Errorf("exec call was wrong: %#v", c)
+	}
+
+	c = calls[1]
+	if c.Method != "Query" || c.Table != "" || c.Operation != UnknownOperation {
+		t.Errorf("query call metadata was wrong: %#v", c)
+	}
+	if c.RowsAffected != -1 || c.Err == nil {
+		t.Errorf("query call was wrong: %#v", c)
+	}
+}
+
+func TestWrapExecutorShortCircuit(t *testing.T) {
+	t.Parallel()
+
+	denied := errors.New("denied")
+	underlying := &testExecutor{}
+	exec := WrapExecutor(underlying, func(ctx context.Context, call *Call, next func(context.Context) error) error {
+		if _, op := OperationFrom(ctx); op == SelectOperation {
+			return denied
+		}
+		return next(ctx)
+	})
+
+	ctx := WithOperation(context.Background(), "pilots", SelectOperation)
+	if _, err := exec.QueryContext(ctx, "select 1"); err != denied {
+		t.Error("want the interceptor's error, got:", err)
+	}
+	if underlying.queried != 0 {
+		t.Error("the query should not have run")
+	}
+}
+
+func TestWrapExecutorQueryRowRejected(t *testing.T) {
+	t.Parallel()
+
+	rejected := errors.New("rejected")
+	exec := WrapExecutor(&testExecutor{}, func(ctx context.Context, call *Call, next func(context.Context) error) error {
+		return rejected
+	})
+
+	var id int
+	if err := QueryRowContext(context.Background(), exec, "select id from pilots").Scan(&id); err != rejected {
+		t.Error("want the interceptor's error from Scan, got:", err)
+	}
+	if err := exec.QueryRowContext(context.Background(), "select id from pilots").Scan(&id); err != rejected {
+		t.Error("want the interceptor's error from the Scan of a *sql.Row, got:", err)
+	}
+
+	exec = WrapExecutor(&testExecutor{}, func(ctx context.Context, call *Call, next func(context.Context) error) error {
+		return nil
+	})
+	if err := QueryRow(exec, "select id from pilots").Scan(&id); err != sql.ErrNoRows {
+		t.Error("want no rows when next isn't called, got:", err)
+	}
+	if err := exec.QueryRow("select id from pilots").Scan(&id); err != sql.ErrNoRows {
+		t.Error("want no rows from the Scan of a *sql.Row when next isn't called, got:", err)
+	}
+}
@@ -0,0 +1,168 @@
+package boil
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// QueryLog is a single statement that was run through an executor
+// wrapped with LogQueries. Args have had sensitive values redacted.
+type QueryLog struct {
+	SQL  string
+	Args []interface{}
+
+	Method    string
+	Table     string
+	Operation Operation
+
+	Duration time.Duration
+	// RowsAffected is -1 when it's not known
+	RowsAffected int64
+	Err          error
+
+	// Caller is the file:line of the code outside of sqlboiler and the
+	// generated models that caused the statement to run, if it was found.
+	Caller string
+}
+
+// QueryLogger receives the statements run through an executor wrapped with
+// LogQueries.
+type QueryLogger interface {
+	LogQuery(ctx context.Context, log QueryLog)
+}
+
+// QueryLoggerFunc allows a plain function to be used as a QueryLogger
+type QueryLoggerFunc func(ctx context.Context, log QueryLog)
+
+// LogQuery calls fn
+func (fn QueryLoggerFunc) LogQuery(ctx context.Context, log QueryLog) {
+	fn(ctx, log)
+}
+
+// LogQueries returns an interceptor that sends every statement to logger
+// once it has run. Values bound to sensitive columns are redacted, see
+// SetSensitiveColumns.
+//
+//	exec := boil.WrapExecutor(db, boil.LogQueries(boil.NewSlogLogger(slog.Default(), slog.LevelDebug)))
+func LogQueries(logger QueryLogger) Interceptor {
+	return func(ctx context.Context, call *Call, next func(context.Context) error) error {
+		err := next(ctx)
+
+		logger.LogQuery(ctx, QueryLog{
+			SQL:          call.SQL,
+			Args:         redactArgs(call.Table, call.SQL, call.ArgColumns, call.Args),
+			Method:       call.Method,
+			Table:        call.Table,
+			Operation:    call.Operation,
+			Duration:     call.Duration,
+			RowsAffected: call.RowsAffected,
+			Err:          call.Err,
+			Caller:       caller(call.Operation != UnknownOperation),
+		})
+
+		return err
+	}
+}
+
+type slogLogger struct {
+	logger *slog.Logger
+	level  slog.Level
+}
+
+// NewSlogLogger creates a QueryLogger that writes to a log/slog logger.
+// Statements are logged at level, or slog.LevelError when they failed.
+func NewSlogLogger(logger *slog.Logger, level slog.Level) QueryLogger {
+	return slogLogger{logger: logger, level: level}
+}
+
+// LogQuery logs the statement with its details as attributes
+func (s slogLogger) LogQuery(ctx context.Context, log QueryLog) {
+	level := s.level
+	if log.Err != nil {
+		level = slog.LevelError
+	}
+	if !s.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("sql", log.SQL),
+		slog.Any("args", log.Args),
+		slog.Duration("duration", log.Duration),
+	}
+	if len(log.Table) != 0 {
+		attrs = append(attrs, slog.String("table", log.Table))
+	}
+	if log.Operation != UnknownOperation {
+		attrs = append(attrs, slog.String("operation", log.Operation.String()))
+	}
+	if log.RowsAffected >= 0 {
+		attrs = append(attrs, slog.Int64("rows_affected", log.RowsAffected))
+	}
+	if len(log.Caller) != 0 {
+		attrs = append(attrs, slog.String("caller", log.Caller))
+	}
+	if log.Err != nil {
+		attrs = append(attrs, slog.Any("error", log.Err))
+	}
+
+	s.logger.LogAttrs(ctx, level, "query", attrs...)
+}
+
+// callerSkipPrefixes are the packages that are never reported as callers
+var callerSkipPrefixes = []string{
+	"github.com/aarondl/sqlboiler/v4/",
+	"database/sql.",
+	"reflect.",
+	"runtime.",
+}
+
+// caller finds the first frame outside of sqlboiler's packages. When the
+// statement came from a generated model the frames of the generated
+// package are skipped as well, it's the package of the first frame found.
+func caller(generated bool) string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var skipPkg string
+	for {
+		frame, more := frames.Next()
+		if !isSkippedCaller(frame.Function) {
+			pkg := funcPackage(frame.Function)
+			switch {
+			case generated && len(skipPkg) == 0:
+				skipPkg = pkg
+			case pkg != skipPkg:
+				return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+			}
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+func isSkippedCaller(function string) bool {
+	for _, prefix := range callerSkipPrefixes {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// funcPackage returns the package path of a fully qualified function name
+// as reported by runtime.Frame, ie: github.com/a/b.(*T).Method
+func funcPackage(function string) string {
+	slash := strings.LastIndexByte(function, '/')
+	if dot := strings.IndexByte(function[slash+1:], '.'); dot >= 0 {
+		return function[:slash+1+dot]
+	}
+	return function
+}
@@ -0,0 +1,106 @@
+package boil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+)
+
+func TestLogQueries(t *testing.T) {
+	t.Parallel()
+
+	SetSensitiveColumns("logger_users", "logger_password")
+
+	var logs []QueryLog
+	logger := QueryLoggerFunc(func(ctx context.Context, log QueryLog) {
+		logs = append(logs, log)
+	})
+
+	exec := WrapExecutor(&testExecutor{}, LogQueries(logger))
+	ctx := WithOperation(context.Background(), "logger_users", UpdateOperation)
+	query := `UPDATE "logger_users" SET "logger_password" = $1 WHERE "id" = $2`
+	if _, err := exec.ExecContext(ctx, query, "hunter2", 5); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(logs) != 1 {
+		t.Fatal("want 1 log, got:", len(logs))
+	}
+
+	log := logs[0]
+	if log.SQL != query || log.Table != "logger_users" || log.Operation != UpdateOperation || log.RowsAffected != 2 {
+		t.Errorf("log was wrong: %#v", log)
+	}
+	if log.Args[0] != RedactedValue || log.Args[1] != 5 {
+		t.Errorf("args were not redacted: %v", log.Args)
+	}
+
+	// The columns in the context are used when the sql can't be read
+	query = `INSERT INTO "logger_users" ("id","logger_password") SELECT $1, lower($2)`
+	ctx = WithArgColumns(ctx, []string{"id", "logger_password"})
+	if _, err := exec.ExecContext(ctx, query, 5, "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+	if log = logs[1]; log.Args[0] != 5 || log.Args[1] != RedactedValue {
+		t.Errorf("args were not redacted by the columns of the context: %v", log.Args)
+	}
+}
+
+func TestSlogLogger(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	logger := NewSlogLogger(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})), slog.LevelDebug)
+
+	logger.LogQuery(context.Background(), QueryLog{
+		SQL:          "select 1",
+		Args:         []interface{}{RedactedValue},
+		Table:        "pilots",
+		Operation:    SelectOperation,
+		RowsAffected: -1,
+		Caller:       "main.go:10",
+		Err:          errors.New("boom"),
+	})
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"level":     "ERROR",
+		"msg":       "query",
+		"sql":       "select 1",
+		"table":     "pilots",
+		"operation": "select",
+		"caller":    "main.go:10",
+		"error":     "boom",
+	}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("%s: want %v, got: %v", k, v, entry[k])
+		}
+	}
+	if _, ok := entry["rows_affected"]; ok {
+		t.Error("rows_affected should not be logged when unknown")
+	}
+}
+
+func TestFuncPackage(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"github.com/a/models.(*User).Insert": "github.com/a/models",
+		"github.com/a/models.Users.func1":    "github.com/a/models",
+		"main.main":                          "main",
+	}
+
+	for fn, pkg := range tests {
+		if got := funcPackage(fn); got != pkg {
+			t.Errorf("%s: want %s, got: %s", fn, pkg, got)
+		}
+	}
+}
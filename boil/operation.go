@@ -0,0 +1,56 @@
+package boil
+
+import "context"
+
+// Operation is the kind of operation a generated model is performing
+type Operation int
+
+// the operation constants
+const (
+	UnknownOperation Operation = iota
+	SelectOperation
+	InsertOperation
+	UpdateOperation
+	DeleteOperation
+	UpsertOperation
+)
+
+// String returns the lowercase name of the operation
+func (o Operation) String() string {
+	switch o {
+	case SelectOperation:
+		return "select"
+	case InsertOperation:
+		return "insert"
+	case UpdateOperation:
+		return "update"
+	case DeleteOperation:
+		return "delete"
+	case UpsertOperation:
+		return "upsert"
+	default:
+		return "unknown"
+	}
+}
+
+type operation struct {
+	table string
+	op    Operation
+}
+
+// WithOperation modifies a context to record which table and operation the
+// queries made using it are for. Generated models call this so that
+// interceptors can tell them apart without parsing sql.
+func WithOperation(ctx context.Context, table string, op Operation) context.Context {
+	return context.WithValue(ctx, ctxOperation, operation{table: table, op: op})
+}
+
+// OperationFrom returns the table and operation recorded in the context, or
+// an empty table and UnknownOperation if not set.
+func OperationFrom(ctx context.Context) (table string, op Operation) {
+	o, ok := ctx.Value(ctxOperation).(operation)
+	if !ok {
+		return "", UnknownOperation
+	}
+	return o.table, o.op
+}
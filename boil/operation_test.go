@@ -0,0 +1,22 @@
+package boil
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOperation(t *testing.T) {
+	t.Parallel()
+
+	if table, op := OperationFrom(context.Background()); table != "" || op != UnknownOperation {
+		t.Error("want nothing set, got:", table, op)
+	}
+
+	ctx := WithOperation(context.Background(), "jets", UpsertOperation)
+	if table, op := OperationFrom(ctx); table != "jets" || op != UpsertOperation {
+		t.Error("want jets upsert, got:", table, op)
+	}
+	if UpsertOperation.String() != "upsert" || Operation(100).String() != "unknown" {
+		t.Error("operation strings were wrong")
+	}
+}
@@ -0,0 +1,395 @@
+package boil
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RedactedValue is what the values of sensitive columns are replaced
+// with in debug output and query logs.
+const RedactedValue = "[REDACTED]"
+
+var (
+	sensitiveMut sync.RWMutex
+	// sensitiveColumns maps table names to sets of column names, the empty
+	// table name holds the columns that are sensitive in every table
+	sensitiveColumns = make(map[string]map[string]struct{})
+)
+
+// SetSensitiveColumns marks columns of a table as sensitive so that the
+// values bound to them are redacted from debug output and query logs.
+// An empty table name marks the columns as sensitive in every table.
+//
+// Generated models call this for the columns configured with the
+// sensitive option.
+func SetSensitiveColumns(table string, columns ...string) {
+	sensitiveMut.Lock()
+	defer sensitiveMut.Unlock()
+
+	set, ok := sensitiveColumns[table]
+	if !ok {
+		set = make(map[string]struct{}, len(columns))
+		sensitiveColumns[table] = set
+	}
+	for _, c := range columns {
+		set[strings.ToLower(c)] = struct{}{}
+	}
+}
+
+// WithArgColumns modifies a context to record the columns that the args of
+// the statement run with it are bound to, in order, so interceptors redact
+// their values by their column instead of by reading the sql. A column can be
+// empty for an arg that isn't bound to one. Statements that insert several
+// rows give the columns of a single row, they're repeated for each row.
+//
+// Generated models call this for the statements they build from their
+// column metadata.
+func WithArgColumns(ctx context.Context, columns []string) context.Context {
+	return context.WithValue(ctx, ctxArgColumns, columns)
+}
+
+// ArgColumnsFrom returns the columns recorded in the context by
+// WithArgColumns, or nil if not set.
+func ArgColumnsFrom(ctx context.Context) []string {
+	columns, _ := ctx.Value(ctxArgColumns).([]string)
+	return columns
+}
+
+// RedactArgs returns args with the values bound to sensitive columns in
+// query replaced by RedactedValue. args is never modified.
+//
+// The columns are found by looking at the sql surrounding each placeholder,
+// which understands comparisons of columns with placeholders, assignments and
+// inserts. Since the table isn't known a column found this way is redacted if
+// it's sensitive in any table, and args whose column can't be found are
+// always redacted. RedactColumnArgs redacts the args of statements whose
+// columns are known.
+func RedactArgs(query string, args []interface{}) []interface{} {
+	return redactArgs("", query, nil, args)
+}
+
+// RedactColumnArgs returns args with the values bound to the sensitive
+// columns of table replaced by RedactedValue, the args are bound to columns
+// like the ones given to WithArgColumns. args is never modified.
+func RedactColumnArgs(table string, columns []string, args []interface{}) []interface{} {
+	return redactArgs(table, "", columns, args)
+}
+
+// redactArgs redacts args by the columns they're bound to when they match
+// the args, otherwise by the ones found in query. It's limited to the
+// sensitive columns of a single table, unless table is empty.
+func redactArgs(table, query string, columns []string, args []interface{}) []interface{} {
+	if len(args) == 0 {
+		return args
+	}
+
+	sensitiveMut.RLock()
+	defer sensitiveMut.RUnlock()
+
+	if len(sensitiveColumns) == 0 {
+		return args
+	}
+
+	var cols []string
+	if len(columns) != 0 && len(args)%len(columns) == 0 {
+		cols = make([]string, len(args))
+		for i := range cols {
+			cols[i] = strings.ToLower(columns[i%len(columns)])
+		}
+	} else {
+		cols = argColumns(query, len(args))
+	}
+
+	var redacted []interface{}
+	for i, col := range cols {
+		if len(col) != 0 && !isSensitive(table, col) {
+			continue
+		}
+
+		if redacted == nil {
+			redacted = append([]interface{}(nil), args...)
+		}
+		redacted[i] = RedactedValue
+	}
+
+	if redacted == nil {
+		return args
+	}
+	return redacted
+}
+
+// isSensitive must be called with sensitiveMut held
+func isSensitive(table, col string) bool {
+	if len(table) == 0 {
+		for _, set := range sensitiveColumns {
+			if _, ok := set[col]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if _, ok := sensitiveColumns[""][col]; ok {
+		return true
+	}
+	_, ok := sensitiveColumns[table][col]
+	return ok
+}
+
+type sqlTokenKind int
+
+const (
+	sqlTokenIdent sqlTokenKind = iota
+	sqlTokenPlaceholder
+	sqlTokenOperator
+	sqlTokenOther
+)
+
+type sqlToken struct {
+	kind sqlTokenKind
+	text string
+	// arg is the index of the argument bound to a placeholder
+	arg int
+}
+
+// argColumns returns the name of the column each of the nargs arguments
+// of query is compared with or assigned to, or an empty string when that
+// can't be determined.
+func argColumns(query string, nargs int) []string {
+	cols := make([]string, nargs)
+	toks := tokenizeSQL(query)
+
+	var insertCols []string
+	valuesAt, insertAt := -1, -1
+	for i, tok := range toks {
+		switch {
+		case isKeyword(tok, "insert"):
+			insertAt = i
+		case isKeyword(tok, "values") && insertAt >= 0:
+			insertCols, valuesAt = insertColumns(toks[insertAt:i]), i
+		}
+
+		if tok.kind != sqlTokenPlaceholder || tok.arg < 0 || tok.arg >= nargs {
+			continue
+		}
+
+		var col string
+		if valuesAt >= 0 {
+			col = insertValueColumn(toks, valuesAt, i, insertCols)
+		}
+		if len(col) == 0 {
+			col = placeholderColumn(toks, i, cols)
+		}
+		cols[tok.arg] = col
+	}
+
+	return cols
+}
+
+// insertColumns returns the column list of an INSERT statement, toks
+// must start at the INSERT keyword and end before VALUES.
+func insertColumns(toks []sqlToken) []string {
+	i := 0
+	for i < len(toks) && toks[i].text != "(" {
+		i++
+	}
+
+	var cols []string
+	for i++; i < len(toks) && toks[i].text != ")"; i++ {
+		if toks[i].kind == sqlTokenIdent {
+			cols = append(cols, toks[i].text)
+		}
+	}
+
+	return cols
+}
+
+// insertValueColumn finds the position of the placeholder at index at in
+// its VALUES tuple
+func insertValueColumn(toks []sqlToken, valuesAt, at int, cols []string) string {
+	depth, pos := 0, 0
+	for i := valuesAt + 1; i < at; i++ {
+		switch toks[i].text {
+		case "(":
+			depth++
+			if depth == 1 {
+				pos = 0
+			}
+		case ")":
+			depth--
+		case ",":
+			if depth == 1 {
+				pos++
+			}
+		}
+	}
+
+	if depth != 1 || pos >= len(cols) {
+		return ""
+	}
+	return cols[pos]
+}
+
+// placeholderColumn walks backwards from the placeholder at index at to
+// find the column it's compared with or assigned to, ie: "col" = $1. It
+// returns an empty string when the placeholder is used in any other way, eg:
+// as the argument of a function.
+func placeholderColumn(toks []sqlToken, at int, cols []string) string {
+	// SELECT $1 AS "col"
+	if at+2 < len(toks) && isKeyword(toks[at+1], "as") && toks[at+2].kind == sqlTokenIdent {
+		return toks[at+2].text
+	}
+
+	for i := at - 1; i >= 0; i-- {
+		tok := toks[i]
+		switch {
+		case tok.kind == sqlTokenOperator, tok.text == "(":
+			continue
+		case tok.text == ",", isKeyword(tok, "and"):
+			// Only in a list of placeholders, "col" IN ($1,$2), or a range,
+			// "col" BETWEEN $1 AND $2, the column is the one of the first
+			if i == 0 || toks[i-1].kind != sqlTokenPlaceholder {
+				return ""
+			}
+		case tok.kind == sqlTokenPlaceholder:
+			if tok.arg >= 0 && tok.arg < len(cols) {
+				return cols[tok.arg]
+			}
+			return ""
+		case tok.kind == sqlTokenIdent:
+			if isSkippedKeyword(tok) {
+				continue
+			}
+			// The name of a function the placeholder is passed to
+			if isStopKeyword(tok) || (i+1 < len(toks) && toks[i+1].text == "(") {
+				return ""
+			}
+			return tok.text
+		default:
+			return ""
+		}
+	}
+
+	return ""
+}
+
+func isKeyword(tok sqlToken, keyword string) bool {
+	return tok.kind == sqlTokenIdent && tok.text == keyword
+}
+
+func isSkippedKeyword(tok sqlToken) bool {
+	switch tok.text {
+	case "in", "not", "like", "ilike", "is", "between", "any", "all":
+		return true
+	}
+	return false
+}
+
+func isStopKeyword(tok sqlToken) bool {
+	switch tok.text {
+	case "select", "values", "where", "set", "on", "or", "having", "case", "when", "then", "else", "limit", "offset", "top", "by":
+		return true
+	}
+	return false
+}
+
+// tokenizeSQL splits a query into the tokens needed by argColumns.
+// Identifiers are unquoted and lowercased, comments are dropped.
+func tokenizeSQL(query string) []sqlToken {
+	var toks []sqlToken
+	nextArg := 0
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '.' || c == ';':
+			i++
+		case c == '\'':
+			end := skipQuoted(query, i, '\'')
+			toks = append(toks, sqlToken{kind: sqlTokenOther, text: query[i:end]})
+			i = end
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				i = len(query)
+			} else {
+				i += end + 4
+			}
+		case c == '"' || c == '`':
+			end := skipQuoted(query, i, c)
+			toks = append(toks, sqlToken{kind: sqlTokenIdent, text: strings.ToLower(strings.Trim(query[i:end], string(c)))})
+			i = end
+		case c == '[':
+			end := strings.IndexByte(query[i:], ']')
+			if end < 0 {
+				end = len(query) - i - 1
+			}
+			toks = append(toks, sqlToken{kind: sqlTokenIdent, text: strings.ToLower(query[i+1 : i+end])})
+			i += end + 1
+		case c == '?':
+			toks = append(toks, sqlToken{kind: sqlTokenPlaceholder, arg: nextArg})
+			nextArg++
+			i++
+		case (c == '$' || c == ':') && i+1 < len(query) && isDigit(query[i+1]):
+			start := i + 1
+			for i = start; i < len(query) && isDigit(query[i]); i++ {
+			}
+			n, _ := strconv.Atoi(query[start:i])
+			toks = append(toks, sqlToken{kind: sqlTokenPlaceholder, arg: n - 1})
+		case c == '@' && i+2 < len(query) && (query[i+1] == 'p' || query[i+1] == 'P') && isDigit(query[i+2]):
+			start := i + 2
+			for i = start; i < len(query) && isDigit(query[i]); i++ {
+			}
+			n, _ := strconv.Atoi(query[start:i])
+			toks = append(toks, sqlToken{kind: sqlTokenPlaceholder, arg: n - 1})
+		case isIdentByte(c):
+			start := i
+			for i < len(query) && (isIdentByte(query[i]) || isDigit(query[i])) {
+				i++
+			}
+			toks = append(toks, sqlToken{kind: sqlTokenIdent, text: strings.ToLower(query[start:i])})
+		case c == '=' || c == '<' || c == '>' || c == '!':
+			start := i
+			for i < len(query) && strings.IndexByte("=<>!", query[i]) >= 0 {
+				i++
+			}
+			toks = append(toks, sqlToken{kind: sqlTokenOperator, text: query[start:i]})
+		default:
+			toks = append(toks, sqlToken{kind: sqlTokenOther, text: string(c)})
+			i++
+		}
+	}
+
+	return toks
+}
+
+// skipQuoted returns the index after the closing quote of the quoted
+// section starting at start, doubled quotes are treated as escapes.
+func skipQuoted(query string, start int, quote byte) int {
+	for i := start + 1; i < len(query); i++ {
+		if query[i] != quote {
+			continue
+		}
+		if i+1 < len(query) && query[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+
+	return len(query)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
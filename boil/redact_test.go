@@ -0,0 +1,79 @@
+package boil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArgColumns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		nargs int
+		cols  []string
+	}{
+		{`INSERT INTO "users" ("name","password") VALUES ($1,$2) RETURNING "id"`, 2, []string{"name", "password"}},
+		{"INSERT INTO `users` (`name`,`password`) VALUES (?,?),(?,?)", 4, []string{"name", "password", "name", "password"}},
+		{`INSERT INTO [users] ([name],[password]) OUTPUT INSERTED.[id] VALUES ($1,$2)`, 2, []string{"name", "password"}},
+		{`UPDATE "users" SET "name"=$1,"password"=$2 WHERE "id"=$3`, 3, []string{"name", "password", "id"}},
+		{`SELECT * FROM "users" WHERE ("users"."token" = $1) AND ("id" IN ($2,$3)) LIMIT 1;`, 3, []string{"token", "id", "id"}},
+		{`SELECT * FROM users WHERE age BETWEEN ? AND ? OR name LIKE ?`, 3, []string{"age", "age", "name"}},
+		{`SELECT * FROM users WHERE secret = 'a = ?' AND password=? -- token = ?`, 1, []string{"password"}},
+		{`SELECT * FROM users WHERE ("a","b") IN (($1,$2))`, 2, []string{"", ""}},
+		{`SELECT * FROM users WHERE lower("email") = $1 OR $2 = "email" OR COALESCE($3, "password") = 'x'`, 3, []string{"", "", ""}},
+		{`SELECT * FROM users WHERE "a" = $1 AND "b" = $2 AND "c" IN ($3, $4)`, 4, []string{"a", "b", "c", "c"}},
+		{"MERGE INTO [t] as [t]\nUSING (SELECT $1) as [s] ([id])\nON ([s].[id] = [t].[id])\nWHEN MATCHED THEN UPDATE SET [password] = $2\nWHEN NOT MATCHED THEN INSERT ([id], [password]) VALUES ($3, $4);", 4, []string{"", "password", "id", "password"}},
+	}
+
+	for i, test := range tests {
+		if got := argColumns(test.query, test.nargs); !reflect.DeepEqual(got, test.cols) {
+			t.Errorf("%d) want: %q, got: %q", i, test.cols, got)
+		}
+	}
+}
+
+func TestRedactArgs(t *testing.T) {
+	t.Parallel()
+
+	SetSensitiveColumns("redact_users", "Redact_Password")
+	SetSensitiveColumns("", "redact_token")
+
+	query := `UPDATE "redact_users" SET "name" = $1, "redact_password" = $2, "redact_token" = $3`
+	args := []interface{}{"bob", "hunter2", "abc"}
+
+	if got := RedactArgs(query, args); !reflect.DeepEqual(got, []interface{}{"bob", RedactedValue, RedactedValue}) {
+		t.Errorf("args were not redacted: %v", got)
+	}
+	if got := redactArgs("redact_pets", query, nil, args); !reflect.DeepEqual(got, []interface{}{"bob", "hunter2", RedactedValue}) {
+		t.Errorf("args were redacted for the wrong table: %v", got)
+	}
+	if args[1] != "hunter2" {
+		t.Error("the original args were modified")
+	}
+
+	query = `SELECT * FROM "redact_users" WHERE lower("name") = $1 OR $2 = "name"`
+	if got := RedactArgs(query, args[:2]); !reflect.DeepEqual(got, []interface{}{RedactedValue, RedactedValue}) {
+		t.Errorf("args without a column were not redacted: %v", got)
+	}
+}
+
+func TestRedactColumnArgs(t *testing.T) {
+	t.Parallel()
+
+	SetSensitiveColumns("redact_accounts", "secret")
+
+	args := []interface{}{"bob", "hunter2"}
+	if got := RedactColumnArgs("redact_accounts", []string{"Name", "Secret"}, args); !reflect.DeepEqual(got, []interface{}{"bob", RedactedValue}) {
+		t.Errorf("args were not redacted by their columns: %v", got)
+	}
+	if got := RedactColumnArgs("redact_accounts", []string{"name", "secret"}, append(args, "alice", "pa55")); !reflect.DeepEqual(got, []interface{}{"bob", RedactedValue, "alice", RedactedValue}) {
+		t.Errorf("the columns were not repeated for each row: %v", got)
+	}
+	if got := RedactColumnArgs("redact_accounts", []string{"name", "secret"}, append(args, "x")); !reflect.DeepEqual(got, []interface{}{RedactedValue, RedactedValue, RedactedValue}) {
+		t.Errorf("args that don't match the columns were not redacted: %v", got)
+	}
+	if got := RedactColumnArgs("redact_other", []string{"name", "secret"}, args); !reflect.DeepEqual(got, args) {
+		t.Errorf("args of another table were redacted: %v", got)
+	}
+}
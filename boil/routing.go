@@ -0,0 +1,169 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync/atomic"
+)
+
+// WithPrimary modifies a context to send every query made using it to the
+// primary of a RoutingExecutor, to read rows right after writing them.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxPrimary, true)
+}
+
+// PrimaryIsForced returns true if the context sends queries to the primary
+func PrimaryIsForced(ctx context.Context) bool {
+	primary := ctx.Value(ctxPrimary)
+	return primary != nil && primary.(bool)
+}
+
+// RoutingExecutor sends reads to a pool of replicas and everything else to
+// the primary. A query is a read when it's a SELECT without a locking clause
+// made outside of an insert, update, delete or upsert operation (see
+// WithOperation), other statements like writable CTEs go to the primary. Replicas are picked round robin,
+// skipping the ones Healthy reports as unhealthy. When there are no healthy
+// replicas or the context was made with WithPrimary reads go to the primary.
+//
+// Transactions are always begun on the primary.
+type RoutingExecutor struct {
+	Primary  ContextExecutor
+	Replicas []ContextExecutor
+
+	// Healthy reports if a replica can be read from, for example by checking
+	// its replication lag. It's called for every read so it should be cheap,
+	// cache the state if it's expensive to find out. All replicas are
+	// healthy when it's nil.
+	Healthy func(ctx context.Context, replica ContextExecutor) bool
+
+	next uint64
+}
+
+// NewRoutingExecutor creates an executor that reads from the replicas and
+// writes to the primary
+func NewRoutingExecutor(primary ContextExecutor, replicas ...ContextExecutor) *RoutingExecutor {
+	return &RoutingExecutor{
+		Primary:  primary,
+		Replicas: replicas,
+	}
+}
+
+// Exec runs the statement on the primary
+func (r *RoutingExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return r.Primary.Exec(query, args...)
+}
+
+// Query runs the query on a replica if it's a read, otherwise on the primary
+func (r *RoutingExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return r.Route(context.Background(), query).Query(query, args...)
+}
+
+// QueryRow runs the query on a replica if it's a read, otherwise on the primary
+func (r *RoutingExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return r.Route(context.Background(), query).QueryRow(query, args...)
+}
+
+// ExecContext runs the statement on the primary
+func (r *RoutingExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return r.Primary.ExecContext(ctx, query, args...)
+}
+
+// QueryContext runs the query on a replica if it's a read, otherwise on the primary
+func (r *RoutingExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return r.Route(ctx, query).QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext runs the query on a replica if it's a read, otherwise on the primary
+func (r *RoutingExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return r.Route(ctx, query).QueryRowContext(ctx, query, args...)
+}
+
+// QueryRowResult runs the query like QueryRowContext, returning the errors of
+// the executor it's routed to that fail before running it
+func (r *RoutingExecutor) QueryRowResult(ctx context.Context, query string, args ...interface{}) Row {
+	return QueryRowContext(ctx, r.Route(ctx, query), query, args...)
+}
+
+// Begin begins a transaction on the primary
+func (r *RoutingExecutor) Begin() (*sql.Tx, error) {
+	beginner, ok := r.Primary.(Beginner)
+	if !ok {
+		return nil, errors.New("primary does not support transactions")
+	}
+	return beginner.Begin()
+}
+
+// BeginTx begins a transaction on the primary
+func (r *RoutingExecutor) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	beginner, ok := r.Primary.(ContextBeginner)
+	if !ok {
+		return nil, errors.New("primary does not support context-aware transactions")
+	}
+	return beginner.BeginTx(ctx, opts)
+}
+
+// Concurrent checks if the primary and all replicas can run queries
+// concurrently
+func (r *RoutingExecutor) Concurrent() bool {
+	if !IsConcurrent(r.Primary) {
+		return false
+	}
+	for _, replica := range r.Replicas {
+		if !IsConcurrent(replica) {
+			return false
+		}
+	}
+	return true
+}
+
+// Route returns the executor a query is sent to
+func (r *RoutingExecutor) Route(ctx context.Context, query string) ContextExecutor {
+	if len(r.Replicas) == 0 || PrimaryIsForced(ctx) || !isRead(ctx, query) {
+		return r.Primary
+	}
+
+	start := atomic.AddUint64(&r.next, 1)
+	for i := 0; i < len(r.Replicas); i++ {
+		replica := r.Replicas[(start+uint64(i))%uint64(len(r.Replicas))]
+		if r.Healthy == nil || r.Healthy(ctx, replica) {
+			return replica
+		}
+	}
+
+	return r.Primary
+}
+
+// isRead checks if a query can be run on a replica
+func isRead(ctx context.Context, query string) bool {
+	switch _, op := OperationFrom(ctx); op {
+	case SelectOperation, UnknownOperation:
+	default:
+		return false
+	}
+
+	// Skip the comments queries may start with, see qm.Comment
+	for {
+		query = strings.TrimLeft(query, " \t\r\n(")
+		var end int
+		switch {
+		case strings.HasPrefix(query, "--"):
+			end = strings.IndexAny(query, "\r\n")
+		case strings.HasPrefix(query, "/*"):
+			if end = strings.Index(query, "*/"); end >= 0 {
+				end += len("*/")
+			}
+		default:
+			if len(query) < 6 || !strings.EqualFold(query[:6], "select") {
+				return false
+			}
+			// Locking reads can't be run on replicas
+			return !strings.Contains(strings.ToUpper(query), " FOR ")
+		}
+		if end < 0 {
+			return false
+		}
+		query = query[end:]
+	}
+}
@@ -0,0 +1,105 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+type routeTestExecutor struct {
+	name string
+}
+
+func (r *routeTestExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return nil, nil
+}
+
+func (r *routeTestExecutor) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, nil
+}
+
+func (r *routeTestExecutor) QueryRow(query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+func (r *routeTestExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return nil, nil
+}
+
+func (r *routeTestExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, nil
+}
+
+func (r *routeTestExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+func TestRoutingExecutorRoute(t *testing.T) {
+	t.Parallel()
+
+	primary := &routeTestExecutor{name: "primary"}
+	one := &routeTestExecutor{name: "one"}
+	two := &routeTestExecutor{name: "two"}
+	r := NewRoutingExecutor(primary, one, two)
+
+	ctx := context.Background()
+	tests := []struct {
+		ctx   context.Context
+		query string
+		want  *routeTestExecutor
+	}{
+		{ctx, "SELECT * FROM pilots", two},
+		{ctx, "select * from pilots", one},
+		{WithOperation(ctx, "pilots", SelectOperation), "-- comment\n/* hint */ SELECT 1", two},
+		{ctx, "(SELECT 1) UNION (SELECT 2)", one},
+		{ctx, "INSERT INTO pilots DEFAULT VALUES", primary},
+		{ctx, "WITH x AS (DELETE FROM pilots RETURNING *) SELECT * FROM x", primary},
+		{ctx, "SELECT * FROM pilots FOR UPDATE", primary},
+		{WithOperation(ctx, "pilots", InsertOperation), "SELECT * FROM pilots", primary},
+		{WithPrimary(ctx), "SELECT * FROM pilots", primary},
+		{ctx, "/* unterminated", primary},
+	}
+
+	for i, test := range tests {
+		if got := r.Route(test.ctx, test.query); got != test.want {
+			t.Errorf("%d) want %s, got %s", i, test.want.name, got.(*routeTestExecutor).name)
+		}
+	}
+}
+
+func TestRoutingExecutorHealthy(t *testing.T) {
+	t.Parallel()
+
+	primary := &routeTestExecutor{name: "primary"}
+	one := &routeTestExecutor{name: "one"}
+	two := &routeTestExecutor{name: "two"}
+	r := NewRoutingExecutor(primary, one, two)
+
+	healthy := map[ContextExecutor]bool{one: true}
+	r.Healthy = func(ctx context.Context, replica ContextExecutor) bool {
+		return healthy[replica]
+	}
+
+	for i := 0; i < 3; i++ {
+		if got := r.Route(context.Background(), "SELECT 1"); got != one {
+			t.Errorf("%d) want the healthy replica, got %s", i, got.(*routeTestExecutor).name)
+		}
+	}
+
+	healthy[one] = false
+	if got := r.Route(context.Background(), "SELECT 1"); got != primary {
+		t.Errorf("want the primary without healthy replicas, got %s", got.(*routeTestExecutor).name)
+	}
+}
+
+func TestRoutingExecutorBegin(t *testing.T) {
+	t.Parallel()
+
+	r := NewRoutingExecutor(&routeTestExecutor{name: "primary"})
+	if _, err := r.BeginTx(context.Background(), nil); err == nil {
+		t.Error("expected an error when the primary can't begin transactions")
+	}
+	if _, err := r.Begin(); err == nil {
+		t.Error("expected an error when the primary can't begin transactions")
+	}
+}
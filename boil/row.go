@@ -0,0 +1,101 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+)
+
+// Row is a row returned by QueryRow, *sql.Row implements it. Its Err and
+// Scan return the error of the query, or the one it failed with before
+// being run.
+type Row interface {
+	Err() error
+	Scan(dest ...interface{}) error
+}
+
+// RowQueryer is implemented by executors whose QueryRow can fail before the
+// query is run, like the ones made by WrapExecutor. A *sql.Row can't hold
+// such an error, QueryRow and QueryRowContext use this to return it.
+type RowQueryer interface {
+	QueryRowResult(ctx context.Context, query string, args ...interface{}) Row
+}
+
+// QueryRow runs a query that returns a single row with exec. The errors of
+// executors that fail before running it are returned by the row.
+func QueryRow(exec Executor, query string, args ...interface{}) Row {
+	if q, ok := exec.(RowQueryer); ok {
+		return q.QueryRowResult(context.Background(), query, args...)
+	}
+	return exec.QueryRow(query, args...)
+}
+
+// QueryRowContext runs a query that returns a single row with exec. The
+// errors of executors that fail before running it are returned by the row.
+func QueryRowContext(ctx context.Context, exec ContextExecutor, query string, args ...interface{}) Row {
+	if q, ok := exec.(RowQueryer); ok {
+		return q.QueryRowResult(ctx, query, args...)
+	}
+	return exec.QueryRowContext(ctx, query, args...)
+}
+
+// ErrorRow returns a row whose Err and Scan return err, for queries that
+// fail before they're run.
+func ErrorRow(err error) Row {
+	return errorRow{err: err}
+}
+
+type errorRow struct {
+	err error
+}
+
+func (r errorRow) Err() error                     { return r.err }
+func (r errorRow) Scan(dest ...interface{}) error { return r.err }
+
+// sqlErrorRow returns a *sql.Row whose Err and Scan return err, for the
+// executor methods that must return one. database/sql only makes them by
+// running queries, so it's made by a query that fails with err on a
+// database of failingConn.
+func sqlErrorRow(err error) *sql.Row {
+	failingDBOnce.Do(func() {
+		failingDB = sql.OpenDB(failingConnector{})
+	})
+	return failingDB.QueryRow("", err)
+}
+
+var (
+	failingDBOnce sync.Once
+	failingDB     *sql.DB
+)
+
+type failingConnector struct{}
+
+func (failingConnector) Connect(context.Context) (driver.Conn, error) { return failingConn{}, nil }
+func (failingConnector) Driver() driver.Driver                        { return failingDriver{} }
+
+type failingDriver struct{}
+
+func (failingDriver) Open(string) (driver.Conn, error) { return failingConn{}, nil }
+
+var errFailingConn = errors.New("boil: the connection only fails queries")
+
+// failingConn fails every query with the error it gets as its argument
+type failingConn struct{}
+
+func (failingConn) Prepare(string) (driver.Stmt, error) { return nil, errFailingConn }
+func (failingConn) Close() error                        { return nil }
+func (failingConn) Begin() (driver.Tx, error)           { return nil, errFailingConn }
+
+// CheckNamedValue accepts the error as it is
+func (failingConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (failingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) == 1 {
+		if err, ok := args[0].Value.(error); ok {
+			return nil, err
+		}
+	}
+	return nil, errFailingConn
+}
@@ -0,0 +1,23 @@
+package boil
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorRow(t *testing.T) {
+	t.Parallel()
+
+	want := errors.New("failed")
+	rows := []Row{ErrorRow(want), sqlErrorRow(want)}
+	for i, row := range rows {
+		if err := row.Err(); err != want {
+			t.Errorf("%d) want the error from Err, got: %v", i, err)
+		}
+
+		var n int
+		if err := row.Scan(&n); err != want {
+			t.Errorf("%d) want the error from Scan, got: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package boil
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoTenant is returned when a query on a table scoped to tenants is run
+// with a context that has no tenant, and it wasn't made for all tenants.
+var ErrNoTenant = errors.New("boil: no tenant in context for a query scoped to tenants")
+
+// ErrTenantConflict is returned by upserts into a table scoped to tenants
+// when a conflicting row belongs to another tenant, it's left as it is.
+var ErrTenantConflict = errors.New("boil: the conflicting row belongs to another tenant")
+
+// WithTenant modifies a context so that queries on tables with the tenant
+// column only see the tenant's rows, and rows inserted into them are given
+// the tenant.
+func WithTenant(ctx context.Context, tenant interface{}) context.Context {
+	return context.WithValue(ctx, ctxTenant, tenant)
+}
+
+// TenantFrom returns the tenant of the context, if it has one
+func TenantFrom(ctx context.Context) (interface{}, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	tenant := ctx.Value(ctxTenant)
+	return tenant, tenant != nil
+}
+
+// WithAllTenants modifies a context so that everything run with it on tables
+// with the tenant column sees the rows of every tenant, like queries made with
+// qm.AllTenants. It's how rows of other tenants are found, reloaded, updated
+// or deleted by their primary key. Rows inserted with it keep their tenant
+// when the context has none.
+func WithAllTenants(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxAllTenants, true)
+}
+
+// IsAllTenants returns true if the context was made for all tenants
+func IsAllTenants(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	all, _ := ctx.Value(ctxAllTenants).(bool)
+	return all
+}
@@ -0,0 +1,26 @@
+package boil
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTenant(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := TenantFrom(context.Background()); ok {
+		t.Error("want no tenant")
+	}
+
+	ctx := WithTenant(context.Background(), int64(5))
+	if tenant, ok := TenantFrom(ctx); !ok || tenant != int64(5) {
+		t.Error("want tenant 5, got:", tenant, ok)
+	}
+
+	if IsAllTenants(ctx) {
+		t.Error("want the context scoped to the tenant")
+	}
+	if !IsAllTenants(WithAllTenants(ctx)) {
+		t.Error("want the context made for all tenants")
+	}
+}
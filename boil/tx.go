@@ -0,0 +1,194 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTxAttempts is how many times InTx runs a transaction that keeps
+// failing with retryable errors when the options don't say otherwise.
+var DefaultTxAttempts = 3
+
+// TxOptions changes how InTx runs a transaction
+type TxOptions struct {
+	// Isolation and ReadOnly are passed on to BeginTx
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+
+	// MaxAttempts is how many times the transaction is run before the last
+	// retryable error is returned, DefaultTxAttempts is used when it's 0.
+	// Set it to 1 to never retry.
+	MaxAttempts int
+	// Retryable decides if the transaction is run again after failing with
+	// err, IsRetryableError is used when it's nil.
+	Retryable func(err error) bool
+	// Backoff is how long to wait before the given retry, counting from 1.
+	// Retries happen right away when it's nil.
+	Backoff func(retry int) time.Duration
+}
+
+// txState is the transaction InTx stores in the context for nested calls
+type txState struct {
+	tx         ContextTransactor
+	savepoints int
+
+	// invalidate are the cache tags to invalidate once tx is committed,
+	// see InvalidateCacheContext
+	mu         sync.Mutex
+	invalidate []string
+}
+
+// invalidateCache adds tags to the cache tags invalidated after commit
+func (s *txState) invalidateCache(tags ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.invalidate = append(s.invalidate, tags...)
+}
+
+// InTx runs fn in a transaction begun on db, which is committed when fn
+// returns nil and rolled back when it returns an error or panics. fn must
+// use the executor and context it's given for the transaction's queries.
+//
+// When ctx is one passed to fn by an outer InTx the transaction isn't begun
+// again. A SAVEPOINT is made instead, which an error or panic in fn rolls
+// back to without ending the outer transaction. db and opts are ignored.
+// MSSQL's savepoint statements differ, so nested calls don't work with it.
+//
+// Transactions failing with an error opts.Retryable accepts, by default
+// serialization failures and deadlocks, are rolled back and fn is run again
+// in a new transaction. fn must therefore be safe to run more than once.
+// Nested calls never retry since the whole transaction has to be.
+func InTx(ctx context.Context, db ContextBeginner, opts *TxOptions, fn func(ctx context.Context, tx ContextExecutor) error) error {
+	if state, ok := ctx.Value(ctxTx).(*txState); ok {
+		return inSavepoint(ctx, state, fn)
+	}
+
+	if opts == nil {
+		opts = &TxOptions{}
+	}
+	attempts := opts.MaxAttempts
+	if attempts <= 0 {
+		attempts = DefaultTxAttempts
+	}
+	retryable := opts.Retryable
+	if retryable == nil {
+		retryable = IsRetryableError
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = runTx(ctx, db, opts, fn)
+		if err == nil || attempt >= attempts || !retryable(err) {
+			return err
+		}
+
+		if opts.Backoff != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(opts.Backoff(attempt)):
+			}
+		} else if ctx.Err() != nil {
+			return err
+		}
+	}
+}
+
+// runTx runs a single attempt of a transaction
+func runTx(ctx context.Context, db ContextBeginner, opts *TxOptions, fn func(ctx context.Context, tx ContextExecutor) error) (err error) {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	state := &txState{tx: tx}
+	if err = fn(context.WithValue(ctx, ctxTx, state), tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	InvalidateCache(state.invalidate...)
+	return nil
+}
+
+// inSavepoint runs fn within a savepoint of the transaction in state
+func inSavepoint(ctx context.Context, state *txState, fn func(ctx context.Context, tx ContextExecutor) error) (err error) {
+	state.savepoints++
+	name := fmt.Sprintf("boil_savepoint_%d", state.savepoints)
+
+	if _, err = state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_, _ = state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(p)
+		}
+	}()
+
+	if err = fn(ctx, state.tx); err != nil {
+		if _, rbErr := state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("%w (rollback to savepoint failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	_, err = state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
+
+// IsRetryableError reports if a transaction that failed with err may succeed
+// when run again. It recognizes serialization failures and deadlocks from
+// the postgres, mysql and mssql drivers, and locked sqlite databases,
+// without depending on the drivers.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// lib/pq and pgx
+	var state interface{ SQLState() string }
+	if errors.As(err, &state) {
+		code := state.SQLState()
+		return code == "40001" || code == "40P01"
+	}
+
+	// go-mssqldb, 1205 is being chosen as a deadlock victim
+	var number interface{ SQLErrorNumber() int32 }
+	if errors.As(err, &number) {
+		return number.SQLErrorNumber() == 1205
+	}
+
+	msg := err.Error()
+	switch {
+	// go-sql-driver/mysql, 1213 is a deadlock
+	case strings.HasPrefix(msg, "Error 1213"):
+		return true
+	case strings.Contains(msg, "database is locked"), strings.Contains(msg, "SQLITE_BUSY"):
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,211 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	_ "modernc.org/sqlite"
+)
+
+func openTxTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection gets its own in memory database
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+
+	if _, err = db.Exec("create table pilots (id integer primary key)"); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func txTestIDs(t *testing.T, db *sql.DB) []int {
+	t.Helper()
+
+	rows, err := db.Query("select id from pilots order by id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err = rows.Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestInTxSavepoints(t *testing.T) {
+	t.Parallel()
+
+	db := openTxTestDB(t)
+	ctx := context.Background()
+	failed := errors.New("failed")
+
+	err := InTx(ctx, db, nil, func(ctx context.Context, tx ContextExecutor) error {
+		if _, err := tx.ExecContext(ctx, "insert into pilots (id) values (1)"); err != nil {
+			return err
+		}
+
+		err := InTx(ctx, db, nil, func(ctx context.Context, tx ContextExecutor) error {
+			if _, err := tx.ExecContext(ctx, "insert into pilots (id) values (2)"); err != nil {
+				return err
+			}
+			return failed
+		})
+		if err != failed {
+			t.Error("want the nested error, got:", err)
+		}
+
+		return InTx(ctx, db, nil, func(ctx context.Context, tx ContextExecutor) error {
+			_, err := tx.ExecContext(ctx, "insert into pilots (id) values (3)")
+			return err
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := txTestIDs(t, db); len(ids) != 2 || ids[0] != 1 || ids[1] != 3 {
+		t.Error("want the rolled back savepoint's row to be missing, got:", ids)
+	}
+}
+
+func TestInTxRollback(t *testing.T) {
+	t.Parallel()
+
+	db := openTxTestDB(t)
+	ctx := context.Background()
+
+	err := InTx(ctx, db, nil, func(ctx context.Context, tx ContextExecutor) error {
+		if _, err := tx.ExecContext(ctx, "insert into pilots (id) values (1)"); err != nil {
+			return err
+		}
+		return errors.New("failed")
+	})
+	if err == nil {
+		t.Error("expected the error to be returned")
+	}
+
+	func() {
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Error("want the panic to be repanicked, got:", p)
+			}
+		}()
+		_ = InTx(ctx, db, nil, func(ctx context.Context, tx ContextExecutor) error {
+			if _, err := tx.ExecContext(ctx, "insert into pilots (id) values (2)"); err != nil {
+				return err
+			}
+			panic("boom")
+		})
+	}()
+
+	if ids := txTestIDs(t, db); len(ids) != 0 {
+		t.Error("want no rows, got:", ids)
+	}
+}
+
+type txTestStateError string
+
+func (t txTestStateError) Error() string    { return "sql state " + string(t) }
+func (t txTestStateError) SQLState() string { return string(t) }
+
+func TestInTxRetry(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	attempts := 0
+	err = InTx(context.Background(), db, &TxOptions{MaxAttempts: 3}, func(ctx context.Context, tx ContextExecutor) error {
+		attempts++
+		if attempts == 1 {
+			return txTestStateError("40001")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if attempts != 2 {
+		t.Error("want 2 attempts, got:", attempts)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	attempts = 0
+	deadlock := txTestStateError("40P01")
+	err = InTx(context.Background(), db, &TxOptions{MaxAttempts: 2}, func(ctx context.Context, tx ContextExecutor) error {
+		attempts++
+		return deadlock
+	})
+	if err != deadlock {
+		t.Error("want the last error, got:", err)
+	}
+	if attempts != 2 {
+		t.Error("want 2 attempts, got:", attempts)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	attempts = 0
+	err = InTx(context.Background(), db, &TxOptions{Retryable: func(error) bool { return false }}, func(ctx context.Context, tx ContextExecutor) error {
+		attempts++
+		return deadlock
+	})
+	if err != deadlock || attempts != 1 {
+		t.Error("want the policy to prevent retries, got:", err, attempts)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{txTestStateError("40001"), true},
+		{txTestStateError("40P01"), true},
+		{txTestStateError("23505"), false},
+		{errors.New("Error 1213: Deadlock found when trying to get lock"), true},
+		{errors.New("Error 1062: Duplicate entry"), false},
+		{errors.New("database is locked (5) (SQLITE_BUSY)"), true},
+		{sql.ErrNoRows, false},
+	}
+
+	for i, test := range tests {
+		if got := IsRetryableError(test.err); got != test.want {
+			t.Errorf("%d) want %t, got %t", i, test.want, got)
+		}
+	}
+}
@@ -89,6 +89,10 @@ func New(config *Config) (*State, error) {
 		}
 	}
 
+	if len(s.Config.AutoColumns.Tenant) != 0 && s.Config.NoContext {
+		return nil, errors.New("the tenant auto column needs the tenant from a context, it can't be used with no-context")
+	}
+
 	s.Driver = drivers.GetDriver(s.Config.DriverName)
 	s.initInflections()
 
@@ -97,6 +101,24 @@ func New(config *Config) (*State, error) {
 		return nil, errors.Wrap(err, "unable to initialize tables")
 	}
 
+	if len(s.Config.SoftDeleteCascade) != 0 {
+		if !s.Config.AddSoftDeletes {
+			return nil, errors.New("soft delete cascades can only be used with add-soft-deletes")
+		}
+		if err := checkSoftDeleteCascades(s.Tables, s.Config.AutoColumns.Deleted, s.Config.SoftDeleteCascade); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(s.Config.Audit) != 0 {
+		if s.Config.NoHooks || s.Config.NoContext {
+			return nil, errors.New("audit tables are written by hooks with the actor of a context, they can't be used with no-hooks or no-context")
+		}
+		if err := checkAudits(s.Tables, s.Config.Audit); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.mergeDriverImports(); err != nil {
 		return nil, errors.Wrap(err, "unable to merge imports from driver")
 	}
@@ -108,8 +130,14 @@ func New(config *Config) (*State, error) {
 	if !s.Config.NoContext {
 		s.Config.Imports.All.Standard = append(s.Config.Imports.All.Standard, `"context"`)
 		s.Config.Imports.Test.Standard = append(s.Config.Imports.Test.Standard, `"context"`)
+
+		queriesTest := s.Config.Imports.TestSingleton["boil_queries_test"]
+		queriesTest.Standard = append(queriesTest.Standard, `"context"`)
+		s.Config.Imports.TestSingleton["boil_queries_test"] = queriesTest
 	}
 
+	s.mergeTenantImports()
+
 	if err := s.processTypeReplacements(); err != nil {
 		return nil, err
 	}
@@ -162,6 +190,9 @@ func (s *State) Run() error {
 		StructTagCasing:       s.Config.StructTagCasing,
 		StructTagCases:        s.Config.StructTagCases,
 		TagIgnore:             make(map[string]struct{}),
+		Sensitive:             make(map[string]struct{}),
+		SoftDeleteCascade:     make(map[string]struct{}),
+		Audit:                 make(map[string]struct{}),
 		Tags:                  s.Config.Tags,
 		RelationTag:           s.Config.RelationTag,
 		Dialect:               s.Dialect,
@@ -184,6 +215,21 @@ func (s *State) Run() error {
 		data.TagIgnore[v] = struct{}{}
 	}
 
+	for _, v := range s.Config.Sensitive {
+		if !rgxValidTableColumn.MatchString(v) {
+			return errors.Errorf("Invalid sensitive column name %q supplied, only specify column name or table.column, eg: password, users.token", v)
+		}
+		data.Sensitive[v] = struct{}{}
+	}
+
+	for _, v := range s.Config.SoftDeleteCascade {
+		data.SoftDeleteCascade[v] = struct{}{}
+	}
+
+	for _, v := range s.Config.Audit {
+		data.Audit[v] = struct{}{}
+	}
+
 	if err := generateSingletonOutput(s, data); err != nil {
 		return errors.Wrap(err, "singleton template output")
 	}
@@ -461,6 +507,17 @@ func (s *State) mergeEnumImports() {
 	}
 }
 
+// mergeTenantImports adds the imports the tests need to create rows for a
+// tenant when a table has the tenant column.
+func (s *State) mergeTenantImports() {
+	for _, table := range s.Tables {
+		if !table.IsView && table.HasTenant(s.Config.AutoColumns.Tenant) {
+			s.Config.Imports = importers.Merge(s.Config.Imports, importers.TenantImports())
+			return
+		}
+	}
+}
+
 // processTypeReplacements checks the config for type replacements
 // and performs them.
 func (s *State) processTypeReplacements() error {
@@ -704,6 +761,108 @@ func checkPKeys(tables []drivers.Table) error {
 	return nil
 }
 
+// checkSoftDeleteCascades ensures every soft delete cascade names the
+// table.column of a to-many relationship between tables that can both be
+// soft deleted, and that cascades don't loop back to a table
+func checkSoftDeleteCascades(tables []drivers.Table, deleteColumn string, cascades []string) error {
+	children := make(map[string][]string)
+	for _, cascade := range cascades {
+		tableName, column, ok := strings.Cut(cascade, ".")
+		if !ok || !rgxValidTableColumn.MatchString(cascade) {
+			return errors.Errorf("invalid soft delete cascade %q supplied, specify the table.column of the foreign key, eg: jets.pilot_id", cascade)
+		}
+
+		found := false
+		for _, t := range tables {
+			if t.IsView || !t.CanSoftDelete(deleteColumn) {
+				continue
+			}
+			for _, rel := range t.ToManyRelationships {
+				if rel.ToJoinTable || rel.ForeignTable != tableName || rel.ForeignColumn != column {
+					continue
+				}
+				if !drivers.GetTable(tables, tableName).CanSoftDelete(deleteColumn) {
+					return errors.Errorf("soft delete cascade %q is to a table that can't be soft deleted", cascade)
+				}
+				children[t.Name] = append(children[t.Name], tableName)
+				found = true
+			}
+		}
+		if !found {
+			return errors.Errorf("soft delete cascade %q is not a to-many relationship of a table that can be soft deleted", cascade)
+		}
+	}
+
+	visiting := make(map[string]bool)
+	var visit func(table string) error
+	visit = func(table string) error {
+		if visiting[table] {
+			return errors.Errorf("soft delete cascades loop back to table %s", table)
+		}
+		visiting[table] = true
+		for _, child := range children[table] {
+			if err := visit(child); err != nil {
+				return err
+			}
+		}
+		visiting[table] = false
+		return nil
+	}
+	for table := range children {
+		if err := visit(table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkAudits ensures every audited table has a primary key and an audit
+// table named after it with a primary key and the columns the audit rows are
+// recorded in
+func checkAudits(tables []drivers.Table, audits []string) error {
+	find := func(name string) *drivers.Table {
+		for i, t := range tables {
+			if t.Name == name && !t.IsView {
+				return &tables[i]
+			}
+		}
+		return nil
+	}
+
+	for _, name := range audits {
+		table := find(name)
+		if table == nil {
+			return errors.Errorf("audited table %q doesn't exist", name)
+		}
+		if table.PKey == nil {
+			return errors.Errorf("audited table %q has no primary key", name)
+		}
+
+		audit := find(name + "_audit")
+		if audit == nil {
+			return errors.Errorf("audited table %q has no audit table %s_audit", name, name)
+		}
+		if audit.PKey == nil {
+			return errors.Errorf("audit table %s_audit has no primary key", name)
+		}
+		for _, col := range []string{"row_id", "operation", "actor", "changed_at", "diff"} {
+			found := false
+			for _, c := range audit.Columns {
+				if c.Name == col {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return errors.Errorf("audit table %s_audit is missing the column %s", name, col)
+			}
+		}
+	}
+
+	return nil
+}
+
 func mergeTemplates(dst, src map[string]templateLoader) {
 	for k, v := range src {
 		dst[k] = v
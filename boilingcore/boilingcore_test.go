@@ -358,3 +358,91 @@ func TestProcessTypeReplacements(t *testing.T) {
 		t.Error("expected disallows:", disallowList)
 	}
 }
+
+func TestCheckSoftDeleteCascades(t *testing.T) {
+	t.Parallel()
+
+	softTable := func(name string, rels ...drivers.ToManyRelationship) drivers.Table {
+		return drivers.Table{
+			Name: name,
+			Columns: []drivers.Column{
+				{Name: "id", Type: "int"},
+				{Name: "deleted_at", Type: "null.Time"},
+			},
+			ToManyRelationships: rels,
+		}
+	}
+	toMany := func(table, foreignTable string) drivers.ToManyRelationship {
+		return drivers.ToManyRelationship{Table: table, Column: "id", ForeignTable: foreignTable, ForeignColumn: table + "_id"}
+	}
+
+	tables := []drivers.Table{
+		softTable("pilots", toMany("pilots", "jets")),
+		softTable("jets", toMany("jets", "pilots")),
+		{Name: "licenses", Columns: []drivers.Column{{Name: "id", Type: "int"}}},
+	}
+	tables[0].ToManyRelationships = append(tables[0].ToManyRelationships, toMany("pilots", "licenses"))
+
+	tests := []struct {
+		cascades []string
+		ok       bool
+	}{
+		{[]string{"jets.pilots_id"}, true},
+		{[]string{"jets"}, false},
+		{[]string{"jets.id"}, false},
+		{[]string{"licenses.pilots_id"}, false},
+		{[]string{"jets.pilots_id", "pilots.jets_id"}, false},
+	}
+
+	for i, test := range tests {
+		err := checkSoftDeleteCascades(tables, "", test.cascades)
+		if test.ok != (err == nil) {
+			t.Errorf("%d) wrong error: %v", i, err)
+		}
+	}
+}
+
+func TestCheckAudits(t *testing.T) {
+	t.Parallel()
+
+	pkey := &drivers.PrimaryKey{Columns: []string{"id"}}
+	auditColumns := []drivers.Column{
+		{Name: "id", Type: "int"},
+		{Name: "row_id", Type: "string"},
+		{Name: "operation", Type: "string"},
+		{Name: "actor", Type: "null.String"},
+		{Name: "changed_at", Type: "time.Time"},
+		{Name: "diff", Type: "types.JSON"},
+	}
+
+	tables := []drivers.Table{
+		{Name: "pilots", PKey: pkey},
+		{Name: "pilots_audit", PKey: pkey, Columns: auditColumns},
+		{Name: "jets", PKey: pkey},
+		{Name: "jets_audit", PKey: pkey, Columns: auditColumns[:4]},
+		{Name: "licenses", PKey: pkey},
+		{Name: "licenses_audit", Columns: auditColumns},
+		{Name: "hangars", PKey: pkey},
+		{Name: "pilots_view", IsView: true},
+		{Name: "pilots_view_audit", PKey: pkey, Columns: auditColumns},
+	}
+
+	tests := []struct {
+		audits []string
+		ok     bool
+	}{
+		{[]string{"pilots"}, true},
+		{[]string{"planes"}, false},
+		{[]string{"jets"}, false},
+		{[]string{"licenses"}, false},
+		{[]string{"hangars"}, false},
+		{[]string{"pilots_view"}, false},
+	}
+
+	for i, test := range tests {
+		err := checkAudits(tables, test.audits)
+		if test.ok != (err == nil) {
+			t.Errorf("%d) wrong error: %v", i, err)
+		}
+	}
+}
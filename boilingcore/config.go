@@ -60,6 +60,10 @@ type Config struct {
 
 	RelationTag string   `toml:"relation_tag,omitempty" json:"relation_tag,omitempty"`
 	TagIgnore   []string `toml:"tag_ignore,omitempty" json:"tag_ignore,omitempty"`
+	Sensitive   []string `toml:"sensitive,omitempty" json:"sensitive,omitempty"`
+
+	SoftDeleteCascade []string `toml:"soft_delete_cascade,omitempty" json:"soft_delete_cascade,omitempty"`
+	Audit             []string `toml:"audit,omitempty" json:"audit,omitempty"`
 
 	Imports importers.Collection `toml:"imports,omitempty" json:"imports,omitempty"`
 
@@ -83,6 +87,8 @@ type AutoColumns struct {
 	Created string `toml:"created,omitempty" json:"created,omitempty"`
 	Updated string `toml:"updated,omitempty" json:"updated,omitempty"`
 	Deleted string `toml:"deleted,omitempty" json:"deleted,omitempty"`
+	Version string `toml:"version,omitempty" json:"version,omitempty"`
+	Tenant  string `toml:"tenant,omitempty" json:"tenant,omitempty"`
 }
 
 type StructTagCases struct {
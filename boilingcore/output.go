@@ -115,9 +115,16 @@ func executeTemplates(e executeTemplateData) error {
 	imps.Standard = e.importSet.Standard
 	imps.ThirdParty = e.importSet.ThirdParty
 	if e.combineImportsOnType {
-		colTypes := make([]string, len(e.data.Table.Columns))
-		for i, ct := range e.data.Table.Columns {
-			colTypes[i] = ct.Type
+		colTypes := make([]string, 0, len(e.data.Table.Columns))
+		for _, ct := range e.data.Table.Columns {
+			colTypes = append(colTypes, ct.Type)
+			// The aggregate finishers return nullable types that no column
+			// may have
+			for _, fn := range aggregateFuncs {
+				if typ := aggregateType(fn.SQL, ct.Type); len(typ) != 0 {
+					colTypes = append(colTypes, typ)
+				}
+			}
 		}
 
 		imps = importers.AddTypeImports(imps, e.state.Config.Imports.BasedOnType, colTypes)
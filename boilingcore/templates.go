@@ -71,6 +71,16 @@ type templateData struct {
 	// Contains field names that should have tags values set to '-'
 	TagIgnore map[string]struct{}
 
+	// Contains field names whose values are redacted from logs
+	Sensitive map[string]struct{}
+
+	// Contains the table.column of the foreign keys that soft deletes and
+	// restores cascade along
+	SoftDeleteCascade map[string]struct{}
+
+	// Contains the tables that record their changes in audit tables
+	Audit map[string]struct{}
+
 	// OutputDirDepth is used to find sqlboiler config file
 	OutputDirDepth int
 
@@ -100,6 +110,47 @@ func (t templateData) SchemaTable(table string) string {
 	return strmangle.SchemaTable(t.LQ, t.RQ, t.Dialect.UseSchema, t.Schema, table)
 }
 
+// SensitiveColumns returns the names of the columns of table that were
+// marked as sensitive in the config
+func (t templateData) SensitiveColumns(table drivers.Table) []string {
+	var cols []string
+	for _, c := range table.Columns {
+		if strmangle.Ignore(table.Name, c.Name, t.Sensitive) {
+			cols = append(cols, c.Name)
+		}
+	}
+
+	return cols
+}
+
+// CascadesSoftDelete checks if soft deleting and restoring the rows of the
+// relationship's table cascades to the rows of its foreign table
+func (t templateData) CascadesSoftDelete(rel drivers.ToManyRelationship) bool {
+	if rel.ToJoinTable {
+		return false
+	}
+	_, ok := t.SoftDeleteCascade[rel.ForeignTable+"."+rel.ForeignColumn]
+	return ok
+}
+
+// SoftDeleteCascaded checks if soft deletes and restores cascade to the
+// rows of the table
+func (t templateData) SoftDeleteCascaded(table string) bool {
+	for cascade := range t.SoftDeleteCascade {
+		if strings.HasPrefix(cascade, table+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Audited checks if the changes to the rows of the table are recorded in its
+// audit table
+func (t templateData) Audited(table string) bool {
+	_, ok := t.Audit[table]
+	return ok
+}
+
 type templateList struct {
 	*template.Template
 }
@@ -331,6 +382,8 @@ var templateFunctions = template.FuncMap{
 	"isPrimitive":            isPrimitive,
 	"isNullPrimitive":        isNullPrimitive,
 	"convertNullToPrimitive": convertNullToPrimitive,
+	"aggregateFuncs":         func() []aggregateFunc { return aggregateFuncs },
+	"aggregateType":          aggregateType,
 	"splitLines": func(a string) []string {
 		if a == "" {
 			return nil
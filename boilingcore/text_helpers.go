@@ -176,3 +176,73 @@ func convertNullToPrimitive(typ string) string {
 	}
 	return typ
 }
+
+// aggregateFunc is an aggregate function that query finishers are generated
+// for, Name is used in the method names.
+type aggregateFunc struct {
+	Name string
+	SQL  string
+}
+
+var aggregateFuncs = []aggregateFunc{
+	{Name: "Sum", SQL: "SUM"},
+	{Name: "Avg", SQL: "AVG"},
+	{Name: "Min", SQL: "MIN"},
+	{Name: "Max", SQL: "MAX"},
+}
+
+// aggregateType returns the type the result of the aggregate function fn
+// over a column of type typ is scanned into, or an empty string if fn can't
+// be used on the column. Aggregates are null when there are no rows so the
+// types are always nullable.
+func aggregateType(fn, typ string) string {
+	prim := convertNullToPrimitive(typ)
+
+	var kind string
+	switch prim {
+	case "int", "int8", "int16", "int32", "int64":
+		kind = "int"
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		kind = "uint"
+	case "float32", "float64":
+		kind = "float"
+	case "types.Decimal", "types.NullDecimal":
+		kind = "decimal"
+	case "time.Time", "null.Time":
+		kind = "time"
+	default:
+		return ""
+	}
+
+	switch fn {
+	case "SUM":
+		switch kind {
+		case "int":
+			return "null.Int64"
+		case "uint":
+			return "null.Uint64"
+		case "float":
+			return "null.Float64"
+		case "decimal":
+			return "types.NullDecimal"
+		}
+	case "AVG":
+		switch kind {
+		case "int", "uint", "float":
+			return "null.Float64"
+		case "decimal":
+			return "types.NullDecimal"
+		}
+	case "MIN", "MAX":
+		switch kind {
+		case "int", "uint", "float":
+			return "null." + strings.ToUpper(prim[:1]) + prim[1:]
+		case "decimal":
+			return "types.NullDecimal"
+		case "time":
+			return "null.Time"
+		}
+	}
+
+	return ""
+}
@@ -125,3 +125,36 @@ func TestTrimSuffixes(t *testing.T) {
 		}
 	}
 }
+
+func TestAggregateType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fn   string
+		typ  string
+		want string
+	}{
+		{"SUM", "int16", "null.Int64"},
+		{"SUM", "null.Uint32", "null.Uint64"},
+		{"SUM", "float32", "null.Float64"},
+		{"SUM", "types.Decimal", "types.NullDecimal"},
+		{"SUM", "time.Time", ""},
+		{"AVG", "int", "null.Float64"},
+		{"AVG", "types.NullDecimal", "types.NullDecimal"},
+		{"AVG", "null.Time", ""},
+		{"MIN", "int16", "null.Int16"},
+		{"MIN", "null.Int16", "null.Int16"},
+		{"MAX", "uint8", "null.Uint8"},
+		{"MAX", "float64", "null.Float64"},
+		{"MAX", "time.Time", "null.Time"},
+		{"MAX", "string", ""},
+		{"MAX", "null.String", ""},
+		{"COUNT", "int", ""},
+	}
+
+	for i, test := range tests {
+		if got := aggregateType(test.fn, test.typ); got != test.want {
+			t.Errorf("%d) %s(%s) want: %q, got: %q", i, test.fn, test.typ, test.want, got)
+		}
+	}
+}
@@ -69,6 +69,9 @@ type Dialect struct {
 	UseLastInsertID      bool `json:"use_last_insert_id"`
 	UseSchema            bool `json:"use_schema"`
 	UseDefaultKeyword    bool `json:"use_default_keyword"`
+	// UseRowValues is set when the database compares row values, eg:
+	// (a, b) > (1, 2), otherwise the comparisons are spelled out
+	UseRowValues bool `json:"use_row_values"`
 
 	// The following is mostly for T-SQL/MSSQL, what a show
 	UseTopClause            bool `json:"use_top_clause"`
@@ -52,6 +52,7 @@ func (m *MockDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, er
 			UseIndexPlaceholders: true,
 			UseLastInsertID:      false,
 			UseTopClause:         false,
+			UseRowValues:         true,
 		},
 	}
 
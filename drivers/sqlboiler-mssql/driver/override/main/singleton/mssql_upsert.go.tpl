@@ -1,5 +1,15 @@
 // buildUpsertQueryMSSQL builds a SQL statement string using the upsertData provided.
-func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, update, insert []string, output []string) string {
+// When version isn't empty matched rows are only updated if their version is
+// one less than the upserted one, it must be in update. When tenant isn't
+// empty they're only updated if they belong to the same tenant, it must be
+// in insert.
+func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, update, insert []string, output []string, version, tenant string) string {
+	tenantIndex := -1
+	for i, v := range insert {
+		if v == tenant {
+			tenantIndex = i
+		}
+	}
 	insert = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, insert)
 
 	buf := strmangle.GetBuffer()
@@ -23,7 +33,16 @@ func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, updat
 	startIndex += len(primary)
 
 	if len(update) > 0 {
-		fmt.Fprint(buf, "WHEN MATCHED THEN ")
+		fmt.Fprint(buf, "WHEN MATCHED ")
+		for i, v := range update {
+			if v == version {
+				fmt.Fprintf(buf, "AND [t].[%s] = %s - 1 ", v, strmangle.Placeholders(dia.UseIndexPlaceholders, 1, startIndex+i, 1))
+			}
+		}
+		if tenantIndex >= 0 {
+			fmt.Fprintf(buf, "AND [t].[%s] = %s ", tenant, strmangle.Placeholders(dia.UseIndexPlaceholders, 1, startIndex+len(update)+tenantIndex, 1))
+		}
+		fmt.Fprint(buf, "THEN ")
 		fmt.Fprintf(buf, "UPDATE SET %s\n", strmangle.SetParamNames(string(dia.LQ), string(dia.RQ), startIndex, update))
 
 		startIndex += len(update)
@@ -42,3 +61,64 @@ func buildUpsertQueryMSSQL(dia drivers.Dialect, tableName string, primary, updat
 
 	return buf.String()
 }
+
+// buildUpsertAllQueryMSSQL builds a SQL statement string that upserts rows
+// rows at once, taking the values of columns from the arguments. The first
+// column of every source row is its index in the statement, it's output
+// along with the inserted columns so that the output can be matched to the
+// rows. When version isn't empty matched rows are only updated if their
+// version is one less than the upserted one, and when tenant isn't empty
+// only if they belong to the same tenant.
+func buildUpsertAllQueryMSSQL(dia drivers.Dialect, tableName string, rows int, columns, primary, update, insert []string, output []string, version, tenant string) string {
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	fmt.Fprintf(buf, "MERGE INTO %s as [t]\n", tableName)
+	buf.WriteString("USING (VALUES ")
+	for i := 0; i < rows; i++ {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(buf, "(%d,%s)", i, strmangle.Placeholders(dia.UseIndexPlaceholders, len(columns), i*len(columns)+1, 1))
+	}
+	fmt.Fprintf(buf, ") as [s] ([boil_row],[%s])\n", strings.Join(columns, "],["))
+
+	fmt.Fprint(buf, "ON (")
+	for i, v := range primary {
+		if i != 0 {
+			fmt.Fprint(buf, " AND ")
+		}
+		fmt.Fprintf(buf, "[s].[%s] = [t].[%s]", v, v)
+	}
+	fmt.Fprint(buf, ")\n")
+
+	if len(update) > 0 {
+		fmt.Fprint(buf, "WHEN MATCHED ")
+		if version != "" {
+			fmt.Fprintf(buf, "AND [t].[%s] = [s].[%s] - 1 ", version, version)
+		}
+		if tenant != "" {
+			fmt.Fprintf(buf, "AND [t].[%s] = [s].[%s] ", tenant, tenant)
+		}
+		fmt.Fprint(buf, "THEN UPDATE SET ")
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			fmt.Fprintf(buf, "[%s] = [s].[%s]", v, v)
+		}
+		buf.WriteByte('\n')
+	}
+
+	fmt.Fprintf(buf, "WHEN NOT MATCHED THEN INSERT ([%s]) VALUES ([s].[%s])\n",
+		strings.Join(insert, "],["),
+		strings.Join(insert, "],[s].["))
+
+	fmt.Fprint(buf, "OUTPUT [s].[boil_row]")
+	for _, v := range output {
+		fmt.Fprintf(buf, ",INSERTED.[%s]", v)
+	}
+	buf.WriteByte(';')
+
+	return buf.String()
+}
@@ -127,6 +127,7 @@ func (m *MySQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 
 			UseLastInsertID: true,
 			UseSchema:       false,
+			UseRowValues:    true,
 		},
 	}
 
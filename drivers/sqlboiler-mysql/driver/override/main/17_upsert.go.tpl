@@ -1,6 +1,11 @@
 {{- if or (not .Table.IsView) .Table.ViewCapabilities.CanUpsert -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $versionCol := .AutoColumns.Version}}
+{{- $versioned := .Table.HasVersion $versionCol}}
+{{- $hasTenant := .Table.HasTenant .AutoColumns.Tenant}}
+{{- $tenantCol := .AutoColumns.Tenant}}
+{{- $guarded := or $versioned $hasTenant}}
 {{if .AddGlobal -}}
 // UpsertG attempts an insert, and does an update or ignore on conflict.
 func (o *{{$alias.UpSingular}}) UpsertG({{if not .NoContext}}ctx context.Context, {{end -}} updateColumns, insertColumns boil.Columns) error {
@@ -40,12 +45,29 @@ var mySQL{{$alias.UpSingular}}UniqueColumns = []string{
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+{{- if $versioned}}
+// Conflicting rows are only updated when their {{$versionCol}} is unchanged, which
+// is then incremented. ErrStaleObject is returned when someone else changed the row first.
+{{- end}}
+{{- if $hasTenant}}
+// Conflicting rows of another tenant are never updated or read, {{if $versioned}}ErrStaleObject
+// is returned when they'd be updated and boil.ErrTenantConflict otherwise{{else}}boil.ErrTenantConflict
+// is returned for them instead{{end}}.
+{{- end}}
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpsertOperation)
+	{{end -}}
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
 	}
 
 	{{- template "timestamp_upsert_helper" . }}
+	{{- if $hasTenant}}
+	if err := o.setTenant(ctx); err != nil {
+		return err
+	}
+	{{- end}}
 
 	{{if not .NoHooks -}}
 	if err := o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
@@ -53,6 +75,13 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	}
 	{{- end}}
 
+	{{if $versioned -}}
+	version := o.{{$alias.Column $versionCol}}
+	if !updateColumns.IsNone() {
+		o.{{$alias.Column $versionCol}}++
+	}
+
+	{{end -}}
 	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
 	nzUniques := queries.NonZeroDefaultSet(mySQL{{$alias.UpSingular}}UniqueColumns, o)
 
@@ -109,11 +138,18 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
 		}
 
+		{{- if $versioned}}
+		if len(update) != 0 {
+			insert = strmangle.SetMerge(insert, []string{"{{$versionCol}}"})
+			update = strmangle.SetMerge(update, []string{"{{$versionCol}}"})
+		}
+		{{- end}}
+
 		ret := strmangle.SetComplement({{$alias.DownSingular}}AllColumns, strmangle.SetIntersect(insert, update))
 
-		cache.query = buildUpsertQueryMySQL(dialect, "{{$schemaTable}}", update, insert)
+		cache.query = buildUpsertQueryMySQL(dialect, "{{$schemaTable}}", update, insert, "{{if $versioned}}{{$versionCol}}{{end}}", "{{if $hasTenant}}{{$tenantCol}}{{end}}")
 		cache.retQuery = fmt.Sprintf(
-			"SELECT %s FROM {{.LQ}}{{.Table.Name}}{{.RQ}} WHERE %s",
+			"SELECT %s FROM {{.LQ}}{{.Table.Name}}{{.RQ}} WHERE %s{{if $hasTenant}} AND {{.LQ}}{{$tenantCol}}{{.RQ}} = ?{{end}}",
 			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
 			strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", 0, nzUniques),
 		)
@@ -122,6 +158,7 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		if err != nil {
 			return err
 		}
+		cache.columns = insert
 		if len(ret) != 0 {
 			cache.retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, ret)
 			if err != nil {
@@ -140,34 +177,59 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, vals)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", cache.columns, vals))
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
+		fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", cache.columns, vals))
 	}
 	{{end -}}
 
 	{{$canLastInsertID := .Table.CanLastInsertID -}}
-	{{if $canLastInsertID -}}
+	{{if or $canLastInsertID $guarded -}}
 		{{if .NoContext -}}
 	result, err := exec.Exec(cache.query, vals...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
+	result, err := exec.ExecContext(boil.WithArgColumns(ctx, cache.columns), cache.query, vals...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, vals...)
+	_, err = exec.ExecContext(boil.WithArgColumns(ctx, cache.columns), cache.query, vals...)
 		{{end -}}
 	{{- end}}
 	if err != nil {
+		{{- if $versioned}}
+		o.{{$alias.Column $versionCol}} = version
+		{{- end}}
 		return errors.Wrap(err, "{{.PkgName}}: unable to upsert for {{.Table.Name}}")
 	}
 
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if $guarded -}}
+	if !updateColumns.IsNone() {
+		rowsAff, err := result.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by upsert for {{.Table.Name}}")
+		}
+		if rowsAff == 0 {
+			{{- if $versioned}}
+			o.{{$alias.Column $versionCol}} = version
+			return ErrStaleObject
+			{{- else}}
+			if err := o.checkUpsertTenant(ctx, exec, nzUniques); err != nil {
+				return err
+			}
+			{{- end}}
+		}
+	}
+
+	{{end -}}
+
 	{{if $canLastInsertID -}}
 	var lastID int64
 	{{- end}}
@@ -198,25 +260,34 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		return errors.Wrap(err, "{{.PkgName}}: unable to retrieve unique values for {{.Table.Name}}")
  	}
 	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+	{{- if $hasTenant}}
+	nzUniqueCols = append(nzUniqueCols, o.{{$alias.Column $tenantCol}})
+	nzUniques = append(nzUniques[:len(nzUniques):len(nzUniques)], "{{$tenantCol}}")
+	{{- end}}
 
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
-		fmt.Fprintln(boil.DebugWriter, nzUniqueCols...)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", nzUniques, nzUniqueCols)...)
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, cache.retQuery)
-		fmt.Fprintln(writer, nzUniqueCols...)
+		fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", nzUniques, nzUniqueCols)...)
 	}
 	{{end -}}
 
 	{{if .NoContext -}}
-	err = exec.QueryRow(cache.retQuery, nzUniqueCols...).Scan(returns...)
+	err = boil.QueryRow(exec, cache.retQuery, nzUniqueCols...).Scan(returns...)
 	{{else -}}
-	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	err = boil.QueryRowContext(boil.WithArgColumns(ctx, nzUniques), exec, cache.retQuery, nzUniqueCols...).Scan(returns...)
 	{{end -}}
+	{{- if $hasTenant}}
+	if errors.Is(err, sql.ErrNoRows) {
+		return boil.ErrTenantConflict
+	}
+	{{- end}}
 	if err != nil {
 		return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
 	}
@@ -228,10 +299,346 @@ CacheNoHooks:
 		{{$alias.DownSingular}}UpsertCacheMut.Unlock()
 	}
 
+	{{if not .Table.IsView -}}
+	o.refreshSnapshot()
+
+	{{end -}}
 	{{if not .NoHooks -}}
 	return o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
 	{{- else -}}
 	return nil
 	{{- end}}
 }
+
+{{if .AddGlobal -}}
+// UpsertAllG attempts to insert all rows in the slice, and does an update or
+// ignore on conflict. See UpsertAll for batching behavior.
+func (o {{$alias.UpSingular}}Slice) UpsertAllG({{if not .NoContext}}ctx context.Context, {{end -}} updateColumns, insertColumns boil.Columns) error {
+	return o.UpsertAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, updateColumns, insertColumns)
+}
+
+{{end -}}
+
+{{if and .AddGlobal .AddPanic -}}
+// UpsertAllGP attempts to insert all rows in the slice, and does an update or
+// ignore on conflict. Panics on error.
+func (o {{$alias.UpSingular}}Slice) UpsertAllGP({{if not .NoContext}}ctx context.Context, {{end -}} updateColumns, insertColumns boil.Columns) {
+	if err := o.UpsertAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// UpsertAllP attempts to insert all rows in the slice using an executor, and
+// does an update or ignore on conflict. UpsertAllP panics on error.
+func (o {{$alias.UpSingular}}Slice) UpsertAllP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) {
+	if err := o.UpsertAll({{if not .NoContext}}ctx, {{end -}} exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// UpsertAll attempts to insert all rows in the slice using an executor, and
+// does an update or ignore on conflict. The rows are sent in multi-row
+// statements holding as many rows as the parameter limit allows.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+//
+// MySQL can't return values from an insert, so when there are columns to
+// populate every row is selected again using its unique columns.
+{{- if $versioned}}
+//
+// Conflicting rows are only updated when their {{$versionCol}} is unchanged, which
+// is then incremented. ErrStaleObject is returned when someone else changed
+// one of them first, and the versions of all rows are restored on error. Run
+// it in a transaction so that the rows written before the error are rolled back too.
+{{- end}}
+{{- if $hasTenant}}
+//
+// Conflicting rows of another tenant are never updated or read, {{if $versioned}}ErrStaleObject
+// is returned when they'd be updated and boil.ErrTenantConflict otherwise{{else}}boil.ErrTenantConflict
+// is returned for them instead{{end}}.
+{{- end}}
+func (o {{$alias.UpSingular}}Slice) UpsertAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) {{if $versioned}}(err error){{else}}error{{end}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpsertOperation)
+	{{end -}}
+	if len(o) == 0 {
+		return nil
+	}
+
+	type upsertGroup struct {
+		nzDefaults []string
+		nzUniques  []string
+		rows       {{$alias.UpSingular}}Slice
+	}
+
+	{{if $versioned -}}
+	bumped := 0
+	defer func() {
+		if err != nil {
+			for _, o := range o[:bumped] {
+				o.{{$alias.Column $versionCol}}--
+			}
+		}
+	}()
+
+	{{end -}}
+	var groups []*upsertGroup
+	groupsByKey := make(map[string]*upsertGroup)
+	for _, o := range o {
+		if o == nil {
+			return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
+		}
+
+		{{- template "timestamp_upsert_helper" . }}
+		{{- if $hasTenant}}
+		if err := o.setTenant(ctx); err != nil {
+			return err
+		}
+		{{- end}}
+
+		{{if not .NoHooks -}}
+		if err := o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+		{{- end}}
+
+		{{if $versioned -}}
+		if !updateColumns.IsNone() {
+			o.{{$alias.Column $versionCol}}++
+			bumped++
+		}
+
+		{{end -}}
+		nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
+		nzUniques := queries.NonZeroDefaultSet(mySQL{{$alias.UpSingular}}UniqueColumns, o)
+
+		if len(nzUniques) == 0 {
+			return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+
+		key := makeCacheKey(insertColumns, nzDefaults) + "." + strings.Join(nzUniques, ".")
+		group, ok := groupsByKey[key]
+		if !ok {
+			group = &upsertGroup{nzDefaults: nzDefaults, nzUniques: nzUniques}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+		group.rows = append(group.rows, o)
+	}
+
+	// The chunks upserted before one that fails stay upserted
+	defer {{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	for _, group := range groups {
+		insert, _ := insertColumns.InsertColumnSet(
+			{{$alias.DownSingular}}AllColumns,
+			{{$alias.DownSingular}}ColumnsWithDefault,
+			{{$alias.DownSingular}}ColumnsWithoutDefault,
+			group.nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			{{$alias.DownSingular}}AllColumns,
+			{{$alias.DownSingular}}PrimaryKeyColumns,
+		)
+		{{if filterColumnsByAuto true .Table.Columns }}
+		insert = strmangle.SetComplement(insert, {{$alias.DownSingular}}GeneratedColumns)
+		update = strmangle.SetComplement(update, {{$alias.DownSingular}}GeneratedColumns)
+		{{- end }}
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
+		}
+
+		{{- if $versioned}}
+		if len(update) != 0 {
+			insert = strmangle.SetMerge(insert, []string{"{{$versionCol}}"})
+			update = strmangle.SetMerge(update, []string{"{{$versionCol}}"})
+		}
+		{{- end}}
+
+		ret := strmangle.SetComplement({{$alias.DownSingular}}AllColumns, strmangle.SetIntersect(insert, update))
+
+		retQuery := fmt.Sprintf(
+			"SELECT %s FROM {{.LQ}}{{.Table.Name}}{{.RQ}} WHERE %s{{if $hasTenant}} AND {{.LQ}}{{$tenantCol}}{{.RQ}} = ?{{end}}",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", 0, group.nzUniques),
+		)
+
+		valueMapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, insert)
+		if err != nil {
+			return err
+		}
+		var retMapping []uint64
+		if len(ret) != 0 {
+			retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+		uniqueMap, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, group.nzUniques)
+		if err != nil {
+			return errors.Wrap(err, "{{.PkgName}}: unable to retrieve unique values for {{.Table.Name}}")
+		}
+		{{- if $hasTenant}}
+		retColumns := append(group.nzUniques[:len(group.nzUniques):len(group.nzUniques)], "{{$tenantCol}}")
+		{{- end}}
+
+		rowsPerQuery := 1
+		if len(insert) != 0 {
+			rowsPerQuery = maxQueryParams / len(insert)
+			if rowsPerQuery > maxInsertRows {
+				rowsPerQuery = maxInsertRows
+			}
+		}
+		{{- if $guarded}}
+		if len(update) != 0 {
+			// MySQL counts inserted and updated rows differently, so {{if $versioned}}stale{{else}}skipped{{end}}
+			// rows can only be told apart when they're upserted one by one
+			rowsPerQuery = 1
+		}
+		{{- end}}
+
+		for start := 0; start < len(group.rows); start += rowsPerQuery {
+			end := start + rowsPerQuery
+			if end > len(group.rows) {
+				end = len(group.rows)
+			}
+			chunk := group.rows[start:end]
+
+			query := buildUpsertAllQueryMySQL(dialect, "{{$schemaTable}}", len(chunk), update, insert, "{{if $versioned}}{{$versionCol}}{{end}}", "{{if $hasTenant}}{{$tenantCol}}{{end}}")
+			var vals []interface{}
+			for _, row := range chunk {
+				vals = append(vals, queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(row)), valueMapping)...)
+			}
+
+			{{if .NoContext -}}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, query)
+				fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", insert, vals))
+			}
+			{{else -}}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, query)
+				fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", insert, vals))
+			}
+			{{end -}}
+
+			{{if .NoContext -}}
+			{{if $guarded}}result{{else}}_{{end}}, err {{if $guarded}}:{{end}}= exec.Exec(query, vals...)
+			{{else -}}
+			{{if $guarded}}result{{else}}_{{end}}, err {{if $guarded}}:{{end}}= exec.ExecContext(boil.WithArgColumns(ctx, insert), query, vals...)
+			{{end -}}
+			if err != nil {
+				return errors.Wrap(err, "{{.PkgName}}: unable to upsert all for {{.Table.Name}}")
+			}
+			{{- if $guarded}}
+			if len(update) != 0 {
+				rowsAff, err := result.RowsAffected()
+				if err != nil {
+					return errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by upsert all for {{.Table.Name}}")
+				}
+				if rowsAff == 0 {
+					{{- if $versioned}}
+					return ErrStaleObject
+					{{- else}}
+					if err := chunk[0].checkUpsertTenant(ctx, exec, group.nzUniques); err != nil {
+						return err
+					}
+					{{- end}}
+				}
+			}
+			{{- end}}
+
+			if len(retMapping) == 0 {
+				continue
+			}
+
+			for _, row := range chunk {
+				value := reflect.Indirect(reflect.ValueOf(row))
+				nzUniqueCols := queries.ValuesFromMapping(value, uniqueMap)
+				{{- if $hasTenant}}
+				nzUniqueCols = append(nzUniqueCols, row.{{$alias.Column $tenantCol}})
+				{{- end}}
+
+				{{if .NoContext -}}
+				if boil.DebugMode {
+					fmt.Fprintln(boil.DebugWriter, retQuery)
+					fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", {{if $hasTenant}}retColumns{{else}}group.nzUniques{{end}}, nzUniqueCols)...)
+				}
+				{{else -}}
+				if boil.IsDebug(ctx) {
+					writer := boil.DebugWriterFrom(ctx)
+					fmt.Fprintln(writer, retQuery)
+					fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", {{if $hasTenant}}retColumns{{else}}group.nzUniques{{end}}, nzUniqueCols)...)
+				}
+				{{end -}}
+
+				{{if .NoContext -}}
+				err = boil.QueryRow(exec, retQuery, nzUniqueCols...).Scan(queries.PtrsFromMapping(value, retMapping)...)
+				{{else -}}
+				err = boil.QueryRowContext(boil.WithArgColumns(ctx, {{if $hasTenant}}retColumns{{else}}group.nzUniques{{end}}), exec, retQuery, nzUniqueCols...).Scan(queries.PtrsFromMapping(value, retMapping)...)
+				{{end -}}
+				{{- if $hasTenant}}
+				if errors.Is(err, sql.ErrNoRows) {
+					return boil.ErrTenantConflict
+				}
+				{{- end}}
+				if err != nil {
+					return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
+				}
+			}
+		}
+	}
+
+	{{if not .Table.IsView -}}
+	for _, o := range o {
+		o.refreshSnapshot()
+	}
+
+	{{end -}}
+	{{if not .NoHooks -}}
+	for _, o := range o {
+		if err := o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+	}
+
+	{{end -}}
+	return nil
+}
+{{- if and $hasTenant (not $versioned)}}
+
+// checkUpsertTenant returns boil.ErrTenantConflict when the row holding the
+// unique values of o belongs to another tenant. Upserts leave such rows as
+// they are, and MySQL doesn't count the rows they leave unchanged apart.
+func (o *{{$alias.UpSingular}}) checkUpsertTenant(ctx context.Context, exec boil.ContextExecutor, uniques []string) error {
+	uniqueMap, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, uniques)
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to retrieve unique values for {{.Table.Name}}")
+	}
+	args := append(queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap), o.{{$alias.Column $tenantCol}})
+	query := buildUpsertTenantQueryMySQL(dialect, "{{$schemaTable}}", uniques, "{{$tenantCol}}")
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, boil.RedactArgs(query, args)...)
+	}
+
+	var own bool
+	if err := boil.QueryRowContext(ctx, exec, query, args...).Scan(&own); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to check the tenant of the conflicting {{.Table.Name}} row")
+	}
+	if !own {
+		return boil.ErrTenantConflict
+	}
+	return nil
+}
+{{- end}}
 {{end}}
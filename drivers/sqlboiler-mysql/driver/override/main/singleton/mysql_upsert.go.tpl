@@ -1,5 +1,14 @@
 // buildUpsertQueryMySQL builds a SQL statement string using the upsertData provided.
-func buildUpsertQueryMySQL(dia drivers.Dialect, tableName string, update, whitelist []string) string {
+func buildUpsertQueryMySQL(dia drivers.Dialect, tableName string, update, whitelist []string, version, tenant string) string {
+	return buildUpsertAllQueryMySQL(dia, tableName, 1, update, whitelist, version, tenant)
+}
+
+// buildUpsertAllQueryMySQL builds a SQL statement string that upserts rows
+// rows at once. When version isn't empty duplicate rows are only updated if
+// their version is one less than the upserted one, it must be in update.
+// When tenant isn't empty they're only updated if they belong to the same
+// tenant, it must be in whitelist.
+func buildUpsertAllQueryMySQL(dia drivers.Dialect, tableName string, rows int, update, whitelist []string, version, tenant string) string {
 	whitelist = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, whitelist)
 	tableName = strmangle.IdentQuote(dia.LQ, dia.RQ, tableName)
 
@@ -12,23 +21,48 @@ func buildUpsertQueryMySQL(dia drivers.Dialect, tableName string, update, whitel
 	}
 
 	if len(update) == 0 {
-		fmt.Fprintf(
-			buf,
-			"INSERT IGNORE INTO %s (%s) VALUES (%s)",
-			tableName,
-			columns,
-			strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), 1, 1),
-		)
+		fmt.Fprintf(buf, "INSERT IGNORE INTO %s (%s) VALUES ", tableName, columns)
+	} else {
+		fmt.Fprintf(buf, "INSERT INTO %s (%s) VALUES ", tableName, columns)
+	}
+	for i := 0; i < rows; i++ {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(buf, "(%s)", strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), i*len(whitelist)+1, 1))
+	}
+
+	if len(update) == 0 {
 		return buf.String()
 	}
 
-	fmt.Fprintf(
-		buf,
-		"INSERT INTO %s (%s) VALUES (%s) ON DUPLICATE KEY UPDATE ",
-		tableName,
-		columns,
-		strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), 1, 1),
-	)
+	buf.WriteString(" ON DUPLICATE KEY UPDATE ")
+
+	var matches []string
+	if version != "" {
+		// Assignments see the columns set before them, so the version has
+		// to be the last one for the others to compare the old version
+		update = append(strmangle.SetComplement(update, []string{version}), version)
+		quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, version)
+		matches = append(matches, fmt.Sprintf("%s = VALUES(%s) - 1", quoted, quoted))
+	}
+	if tenant != "" {
+		// The tenant is never changed by the update, so it can be anywhere
+		quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, tenant)
+		matches = append(matches, fmt.Sprintf("%s = VALUES(%s)", quoted, quoted))
+	}
+
+	if len(matches) != 0 {
+		matches := strings.Join(matches, " AND ")
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, v)
+			fmt.Fprintf(buf, "%s = IF(%s, VALUES(%s), %s)", quoted, matches, quoted, quoted)
+		}
+		return buf.String()
+	}
 
 	for i, v := range update {
 		if i != 0 {
@@ -43,3 +77,13 @@ func buildUpsertQueryMySQL(dia drivers.Dialect, tableName string, update, whitel
 
 	return buf.String()
 }
+
+// buildUpsertTenantQueryMySQL builds a SQL statement string that checks
+// whether the row holding the unique values belongs to the tenant.
+func buildUpsertTenantQueryMySQL(dia drivers.Dialect, tableName string, uniques []string, tenant string) string {
+	return fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE %s AND %s = ?)",
+		strmangle.IdentQuote(dia.LQ, dia.RQ, tableName),
+		strmangle.WhereClause(string(dia.LQ), string(dia.RQ), 0, uniques),
+		strmangle.IdentQuote(dia.LQ, dia.RQ, tenant),
+	)
+}
@@ -7,3 +7,13 @@ func TestUpsert(t *testing.T) {
   {{end -}}
   {{- end -}}
 }
+
+func TestUpsertAll(t *testing.T) {
+  {{- range $index, $table := .Tables}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table $table.Name}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}UpsertAll)
+  {{end -}}
+  {{- end -}}
+}
@@ -1,4 +1,5 @@
 {{- $alias := .Aliases.Table .Table.Name}}
+{{- $versionCol := .AutoColumns.Version}}
 func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 	t.Parallel()
 
@@ -17,7 +18,7 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}{{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}}{{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Upsert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer()); err != nil {
@@ -33,7 +34,7 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 	}
 
 	// Attempt the UPDATE side of an UPSERT
-	if err = randomize.Struct(seed, &o, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+	if err = randomize.Struct(seed, &o, {{$alias.DownSingular}}DBTypes, false, {{if .Table.HasVersion $versionCol}}append([]string{"{{$versionCol}}"}, {{$alias.DownSingular}}PrimaryKeyColumns...){{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -48,4 +49,90 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 	if count != 1 {
 		t.Error("want one record, got:", count)
 	}
+	{{- if and (not .NoContext) (.Table.HasTenant .AutoColumns.Tenant)}}
+
+	// The row can't be taken over by the upsert of another tenant
+	other := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, other, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if o.{{$alias.Column .AutoColumns.Tenant}} == other.{{$alias.Column .AutoColumns.Tenant}} {
+		t.Skip("Skipping a random tenant that is the test tenant")
+	}
+	if err = o.Upsert(boil.WithTenant(ctx, other.{{$alias.Column .AutoColumns.Tenant}}), tx, boil.Infer(), boil.Infer()); err != {{if .Table.HasVersion $versionCol}}ErrStaleObject{{else}}boil.ErrTenantConflict{{end}} {
+		t.Error("want a conflict upserting for another tenant, got:", err)
+	}
+	if count, err := {{$alias.UpPlural}}().Count(ctx, tx); err != nil || count != 1 {
+		t.Error("want the record kept by the tenant, got:", count, err)
+	}
+	{{- end}}
+}
+
+func test{{$alias.UpPlural}}UpsertAll(t *testing.T) {
+	t.Parallel()
+	if len({{$alias.DownSingular}}AllColumns) == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+	if len(mySQL{{$alias.UpSingular}}UniqueColumns) == 0 {
+		t.Skip("Skipping table with no unique columns to conflict on")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o1 := &{{$alias.UpSingular}}{}
+	o2 := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o1, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, o2, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	slice := {{$alias.UpSingular}}Slice{o1, o2}
+	if err = slice.UpsertAll({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert all {{$alias.UpSingular}}: %s", err)
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, o1, {{$alias.DownSingular}}DBTypes, false, {{if .Table.HasVersion $versionCol}}append([]string{"{{$versionCol}}"}, {{$alias.DownSingular}}PrimaryKeyColumns...){{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, o2, {{$alias.DownSingular}}DBTypes, false, {{if .Table.HasVersion $versionCol}}append([]string{"{{$versionCol}}"}, {{$alias.DownSingular}}PrimaryKeyColumns...){{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	if err = slice.UpsertAll({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert all {{$alias.UpSingular}}: %s", err)
+	}
+
+	count, err = {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+	{{- if .Table.HasVersion $versionCol}}
+
+	version := o1.{{$alias.Column $versionCol}}
+	o2.{{$alias.Column $versionCol}}--
+	if err = slice.UpsertAll({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer(), boil.Infer()); err != ErrStaleObject {
+		t.Error("want a stale object error, got:", err)
+	}
+	if o1.{{$alias.Column $versionCol}} != version {
+		t.Error("the versions of stale objects should be restored")
+	}
+	{{- end}}
 }
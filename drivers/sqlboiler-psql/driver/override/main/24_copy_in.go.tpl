@@ -0,0 +1,330 @@
+{{- if or (not .Table.IsView) (.Table.ViewCapabilities.CanInsert) -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $hasTenant := .Table.HasTenant .AutoColumns.Tenant}}
+{{- $versionCol := .AutoColumns.Version}}
+{{- $versioned := .Table.HasVersion $versionCol}}
+{{- $guarded := or $versioned $hasTenant}}
+{{- $conflictErr := "ErrStaleObject"}}
+{{- if not $versioned}}{{$conflictErr = "boil.ErrTenantConflict"}}{{end}}
+{{if .AddGlobal -}}
+// CopyInG inserts all rows in the slice with COPY FROM STDIN using the global
+// database handle. See CopyIn for column behavior.
+func (o {{$alias.UpSingular}}Slice) CopyInG({{if not .NoContext}}ctx context.Context{{end}}) error {
+	return o.CopyIn({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}})
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// CopyInP inserts all rows in the slice with COPY FROM STDIN, and panics on
+// error. See CopyIn for column behavior.
+func (o {{$alias.UpSingular}}Slice) CopyInP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) {
+	if err := o.CopyIn({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// CopyIn inserts all rows in the slice with COPY FROM STDIN, which is much
+// faster than InsertAll for large amounts of rows. COPY can only be run in a
+// transaction, when exec is a database handle one is started for it.
+//
+// Rows are grouped by which of their columns with defaults are set like
+// InsertAll, and each group is copied with its own COPY.
+// Nothing is returned by COPY so the rows are not updated with default values.
+// The insert hooks are run for every row.
+func (o {{$alias.UpSingular}}Slice) CopyIn({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	{{if .NoContext -}}
+	ctx := copyInContext
+	{{else -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.InsertOperation)
+	{{end -}}
+	if len(o) == 0 {
+		return nil
+	}
+
+	for _, row := range o {
+		if row == nil {
+			return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for insertion")
+		}
+		if err := row.beforeInsert({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+	}
+
+	groups, err := o.copyInGroups()
+	if err != nil {
+		return err
+	}
+
+	err = runCopyIn(ctx, exec, func(tx copyInTx) error {
+		for _, group := range groups {
+			if err := copyInRows(ctx, tx, {{if .Dialect.UseSchema}}"{{.Schema}}"{{else}}""{{end}}, "{{.Table.Name}}", group.columns, len(group.rows), group.values); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to copy into {{.Table.Name}}")
+	}
+
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if not .Table.IsView -}}
+	for _, row := range o {
+		row.refreshSnapshot()
+	}
+
+	{{end -}}
+	{{if not .NoHooks -}}
+	for _, row := range o {
+		if err := row.doAfterInsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+	}
+
+	{{end -}}
+	return nil
+}
+
+{{if .AddGlobal -}}
+// CopyInConflictG inserts all rows in the slice with COPY FROM STDIN using the
+// global database handle, and does an update or ignore on conflict.
+// See CopyInConflict for details.
+func (o {{$alias.UpSingular}}Slice) CopyInConflictG({{if not .NoContext}}ctx context.Context, {{end -}} updateOnConflict bool, conflictColumns []string, updateColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.CopyInConflict({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, updateOnConflict, conflictColumns, updateColumns, opts...)
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// CopyInConflictP inserts all rows in the slice with COPY FROM STDIN, and
+// does an update or ignore on conflict. Panics on error.
+// See CopyInConflict for details.
+func (o {{$alias.UpSingular}}Slice) CopyInConflictP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns boil.Columns, opts ...UpsertOptionFunc) {
+	if err := o.CopyInConflict({{if not .NoContext}}ctx, {{end -}} exec, updateOnConflict, conflictColumns, updateColumns, opts...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// CopyInConflict copies all rows in the slice into a temporary table with
+// COPY FROM STDIN, and inserts them from there with INSERT ... ON CONFLICT,
+// doing an update or ignore on conflict like Upsert. The rows are grouped
+// and copied like CopyIn's, only the copied columns of a group are updated.
+// The slice must not contain rows that conflict with each other when
+// updating on conflict.
+// See boil.Columns documentation for how to properly use updateColumns.
+//
+// Nothing is returned to the rows. The upsert hooks are run for every row.
+{{- if $versioned}}
+//
+// Conflicting rows are only updated when their {{$versionCol}} is unchanged, which
+// is then incremented like UpsertAll's. ErrStaleObject is returned when someone
+// else changed one of them first, and the versions of all rows are restored.
+// The copy is rolled back with the transaction it was run in.
+{{- end}}
+{{- if $hasTenant}}
+//
+// Conflicting rows of another tenant are never updated, {{$conflictErr}} is
+// returned for them instead.
+{{- end}}
+{{- if .Audited .Table.Name}}
+// The rows aren't audited, it fails unless the hooks of the context are skipped.
+{{- end}}
+func (o {{$alias.UpSingular}}Slice) CopyInConflict({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns boil.Columns, opts ...UpsertOptionFunc) {{if $versioned}}(err error){{else}}error{{end}} {
+	{{if .NoContext -}}
+	ctx := copyInContext
+	{{else -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpsertOperation)
+	{{end -}}
+	if len(o) == 0 {
+		return nil
+	}
+	{{- if .Audited .Table.Name}}
+	if !boil.HooksAreSkipped(ctx) {
+		return errors.New("{{.PkgName}}: unable to copy into {{.Table.Name}}, the rows updated on conflict aren't known so they can't be audited, use UpsertAll instead")
+	}
+	{{- end}}
+
+	{{if $versioned -}}
+	bumped := 0
+	defer func() {
+		if err != nil {
+			for _, row := range o[:bumped] {
+				row.{{$alias.Column $versionCol}}--
+			}
+		}
+	}()
+
+	{{end -}}
+	for _, row := range o {
+		if row == nil {
+			return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
+		}
+		if err := row.beforeCopyInConflict({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+		{{- if $versioned}}
+		if updateOnConflict {
+			row.{{$alias.Column $versionCol}}++
+			bumped++
+		}
+		{{- end}}
+	}
+
+	groups, err := o.copyInGroups()
+	if err != nil {
+		return err
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		{{$alias.DownSingular}}AllColumns,
+		{{$alias.DownSingular}}PrimaryKeyColumns,
+	)
+	{{- if $versioned}}
+	if len(update) != 0 {
+		update = strmangle.SetMerge(update, []string{"{{$versionCol}}"})
+	}
+	{{- end}}
+
+	conflict := conflictColumns
+	if len(conflict) == 0 && updateOnConflict {
+		if len({{$alias.DownSingular}}PrimaryKeyColumns) == 0 {
+			return errors.New("{{.PkgName}}: unable to copy into {{.Table.Name}}, could not build conflict column list")
+		}
+
+		conflict = make([]string, len({{$alias.DownSingular}}PrimaryKeyColumns))
+		copy(conflict, {{$alias.DownSingular}}PrimaryKeyColumns)
+	}
+
+	for _, group := range groups {
+		group.update = strmangle.SetIntersect(update, group.columns)
+		if updateOnConflict && len(group.update) == 0 {
+			return errors.New("{{.PkgName}}: unable to copy into {{.Table.Name}}, could not build update column list")
+		}
+	}
+	{{- if $versioned}}
+	opts = append(opts[:len(opts):len(opts)], upsertVersionCheck("{{$schemaTable}}", "{{$versionCol}}"))
+	{{- end}}
+	{{- if $hasTenant}}
+	opts = append(opts[:len(opts):len(opts)], upsertTenantCheck("{{$schemaTable}}", "{{.AutoColumns.Tenant}}"))
+	{{- end}}
+
+	err = runCopyIn(ctx, exec, func(tx copyInTx) error {
+		for _, group := range groups {
+			{{if $guarded}}written{{else}}_{{end}}, err := copyInUpsert(ctx, tx, "{{$schemaTable}}", "{{.Table.Name}}", group.columns, len(group.rows), group.values, func(source string) string {
+				return buildUpsertSelectQueryPostgres(dialect, "{{$schemaTable}}", source, updateOnConflict, group.update, conflict, group.columns, opts...)
+			})
+			if err != nil {
+				return err
+			}
+			{{- if $guarded}}
+			if updateOnConflict && written != int64(len(group.rows)) {
+				return {{$conflictErr}}
+			}
+			{{- end}}
+		}
+		return nil
+	})
+	{{- if $guarded}}
+	if err == {{$conflictErr}} {
+		return err
+	}
+	{{- end}}
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to copy into {{.Table.Name}}")
+	}
+
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if not .Table.IsView -}}
+	for _, row := range o {
+		row.refreshSnapshot()
+	}
+
+	{{end -}}
+	{{if not .NoHooks -}}
+	for _, row := range o {
+		if err := row.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+	}
+
+	{{end -}}
+	return nil
+}
+
+// {{$alias.DownSingular}}CopyInGroup is a group of rows that copy the same columns.
+type {{$alias.DownSingular}}CopyInGroup struct {
+	columns      []string
+	update       []string
+	valueMapping []uint64
+	rows         {{$alias.UpSingular}}Slice
+}
+
+// values returns the values of the copied columns of the i-th row.
+func (g *{{$alias.DownSingular}}CopyInGroup) values(i int) []interface{} {
+	return queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(g.rows[i])), g.valueMapping)
+}
+
+// copyInGroups groups the rows by which of their columns with defaults are
+// set, and finds the columns each group copies.
+func (o {{$alias.UpSingular}}Slice) copyInGroups() ([]*{{$alias.DownSingular}}CopyInGroup, error) {
+	var groups []*{{$alias.DownSingular}}CopyInGroup
+	groupsByKey := make(map[string]*{{$alias.DownSingular}}CopyInGroup)
+	for _, row := range o {
+		{{if .Table.IsView -}}
+		nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, row)
+		{{- else -}}
+		nzDefaults := row.insertDefaults()
+		{{- end}}
+		key := makeCacheKey(boil.Infer(), nzDefaults)
+		group, ok := groupsByKey[key]
+		if !ok {
+			columns, _ := boil.Infer().InsertColumnSet(
+				{{$alias.DownSingular}}AllColumns,
+				{{$alias.DownSingular}}ColumnsWithDefault,
+				{{$alias.DownSingular}}ColumnsWithoutDefault,
+				nzDefaults,
+			)
+			{{- if filterColumnsByAuto true .Table.Columns }}
+			columns = strmangle.SetComplement(columns, {{$alias.DownSingular}}GeneratedColumns)
+			{{- end}}
+
+			valueMapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, columns)
+			if err != nil {
+				return nil, err
+			}
+
+			group = &{{$alias.DownSingular}}CopyInGroup{columns: columns, valueMapping: valueMapping}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+		group.rows = append(group.rows, row)
+	}
+
+	return groups, nil
+}
+
+// beforeCopyInConflict sets the automatic columns of a row that's about to be
+// upserted by CopyInConflict and runs its before upsert hooks.
+func (o *{{$alias.UpSingular}}) beforeCopyInConflict({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	{{- template "timestamp_upsert_helper" . }}
+	{{- if $hasTenant}}
+	if err := o.setTenant(ctx); err != nil {
+		return err
+	}
+	{{- end}}
+
+	{{if not .NoHooks -}}
+	return o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
+	{{- else -}}
+	return nil
+	{{- end}}
+}
+{{- end -}}
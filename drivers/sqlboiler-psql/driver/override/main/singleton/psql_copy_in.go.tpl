@@ -0,0 +1,114 @@
+{{if .NoContext -}}
+// copyInContext is the context that COPY is run with, since the models are
+// generated without context support
+var copyInContext = context.Background()
+
+{{end -}}
+
+// copyInTx is a transaction that COPY FROM STDIN can be run in
+type copyInTx interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// runCopyIn calls fn with a transaction, COPY can't be run outside of one.
+// When exec is a database handle a transaction is started and committed
+// once fn returns, otherwise exec must already be a transaction.
+func runCopyIn(ctx context.Context, exec interface{}, fn func(tx copyInTx) error) error {
+	if beginner, ok := exec.(boil.ContextBeginner); ok {
+		tx, err := beginner.BeginTx(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "{{.PkgName}}: unable to begin transaction for copy in")
+		}
+		if err = fn(tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	}
+
+	tx, ok := exec.(copyInTx)
+	if !ok {
+		return errors.New("{{.PkgName}}: copy in needs a database handle or a transaction that statements can be prepared on")
+	}
+	return fn(tx)
+}
+
+// copyInRows streams n rows into a table using COPY FROM STDIN. The values
+// of each row are converted with their Value method by database/sql.
+func copyInRows(ctx context.Context, tx copyInTx, schema, table string, columns []string, n int, values func(i int) []interface{}) error {
+	var query string
+	if len(schema) != 0 {
+		query = pq.CopyInSchema(schema, table, columns...)
+	} else {
+		query = pq.CopyIn(table, columns...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintf(writer, "[%d rows]\n", n)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		if _, err = stmt.ExecContext(ctx, values(i)...); err != nil {
+			_ = stmt.Close()
+			return err
+		}
+	}
+
+	// Executing the statement without arguments flushes the copied rows
+	if _, err = stmt.ExecContext(ctx); err != nil {
+		_ = stmt.Close()
+		return err
+	}
+
+	return stmt.Close()
+}
+
+// copyInUpsert copies n rows into a temporary table holding the columns of
+// table, and inserts them from there with the query built by buildQuery.
+// The temporary table is named after table and passed to buildQuery quoted.
+// The number of rows written by the query is returned.
+func copyInUpsert(ctx context.Context, tx copyInTx, schemaTable, table string, columns []string, n int, values func(i int) []interface{}, buildQuery func(source string) string) (int64, error) {
+	temp := "boil_copy_" + table
+	source := pq.QuoteIdentifier(temp)
+
+	queries := []string{
+		fmt.Sprintf(`CREATE TEMPORARY TABLE %s ON COMMIT DROP AS SELECT "%s" FROM %s WITH NO DATA`, source, strings.Join(columns, `","`), schemaTable),
+		buildQuery(source),
+		// Dropped now instead of at commit so that it can be created again
+		// in the same transaction
+		"DROP TABLE " + source,
+	}
+
+	var written int64
+	for i, query := range queries {
+		if i == 1 {
+			if err := copyInRows(ctx, tx, "", temp, columns, n, values); err != nil {
+				return 0, err
+			}
+		}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+		}
+		result, err := tx.ExecContext(ctx, query)
+		if err != nil {
+			return 0, err
+		}
+		if i == 1 {
+			if written, err = result.RowsAffected(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return written, nil
+}
@@ -1,6 +1,12 @@
 type UpsertOptions struct {
 	conflictTarget string
 	updateSet string
+
+	versionTable  string
+	versionColumn string
+
+	tenantTable  string
+	tenantColumn string
 }
 
 type UpsertOptionFunc func(o *UpsertOptions)
@@ -17,33 +23,86 @@ func UpsertUpdateSet(updateSet string) UpsertOptionFunc {
 	}
 }
 
+// upsertVersionCheck only updates conflicting rows whose version is one less
+// than the upserted one, which is how Upsert locks versioned tables.
+func upsertVersionCheck(tableName, column string) UpsertOptionFunc {
+	return func(o *UpsertOptions) {
+		o.versionTable = tableName
+		o.versionColumn = column
+	}
+}
+
+// upsertTenantCheck only updates conflicting rows of the same tenant as the
+// upserted one, which is how Upsert keeps to the tenant of scoped tables.
+func upsertTenantCheck(tableName, column string) UpsertOptionFunc {
+	return func(o *UpsertOptions) {
+		o.tenantTable = tableName
+		o.tenantColumn = column
+	}
+}
+
 // buildUpsertQueryPostgres builds a SQL statement string using the upsertData provided.
 func buildUpsertQueryPostgres(dia drivers.Dialect, tableName string, updateOnConflict bool, ret, update, conflict, whitelist []string, opts ...UpsertOptionFunc) string {
+	return buildUpsertAllQueryPostgres(dia, tableName, 1, updateOnConflict, ret, update, conflict, whitelist, opts...)
+}
+
+// buildUpsertAllQueryPostgres builds a SQL statement string that upserts
+// rows rows at once, it can't be used for more than one row when whitelist
+// is empty.
+func buildUpsertAllQueryPostgres(dia drivers.Dialect, tableName string, rows int, updateOnConflict bool, ret, update, conflict, whitelist []string, opts ...UpsertOptionFunc) string {
 	conflict = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, conflict)
 	whitelist = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, whitelist)
 	ret = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, ret)
 
-	upsertOpts := &UpsertOptions{}
-	for _, o := range opts {
-		o(upsertOpts)
-	}
-
 	buf := strmangle.GetBuffer()
 	defer strmangle.PutBuffer(buf)
 
-	columns := "DEFAULT VALUES"
 	if len(whitelist) != 0 {
-		columns = fmt.Sprintf("(%s) VALUES (%s)",
-			strings.Join(whitelist, ", "),
-			strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), 1, 1))
+		fmt.Fprintf(buf, "INSERT INTO %s (%s) VALUES ", tableName, strings.Join(whitelist, ", "))
+		for i := 0; i < rows; i++ {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			fmt.Fprintf(buf, "(%s)", strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), i*len(whitelist)+1, 1))
+		}
+		buf.WriteString(" ON CONFLICT ")
+	} else {
+		fmt.Fprintf(buf, "INSERT INTO %s DEFAULT VALUES ON CONFLICT ", tableName)
 	}
 
-	fmt.Fprintf(
-		buf,
-		"INSERT INTO %s %s ON CONFLICT ",
-		tableName,
-		columns,
-	)
+	writeUpsertConflictPostgres(buf, dia, updateOnConflict, update, conflict, opts...)
+
+	if len(ret) != 0 {
+		buf.WriteString(" RETURNING ")
+		buf.WriteString(strings.Join(ret, ", "))
+	}
+
+	return buf.String()
+}
+
+// buildUpsertSelectQueryPostgres builds a SQL statement string that upserts
+// the rows of the source table, which must have the whitelisted columns.
+func buildUpsertSelectQueryPostgres(dia drivers.Dialect, tableName, source string, updateOnConflict bool, update, conflict, whitelist []string, opts ...UpsertOptionFunc) string {
+	conflict = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, conflict)
+	whitelist = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, whitelist)
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	columns := strings.Join(whitelist, ", ")
+	fmt.Fprintf(buf, "INSERT INTO %s (%s) SELECT %s FROM %s ON CONFLICT ", tableName, columns, columns, source)
+	writeUpsertConflictPostgres(buf, dia, updateOnConflict, update, conflict, opts...)
+
+	return buf.String()
+}
+
+// writeUpsertConflictPostgres writes the conflict target and action of an
+// upsert, the conflict columns must already be quoted.
+func writeUpsertConflictPostgres(buf *bytes.Buffer, dia drivers.Dialect, updateOnConflict bool, update, conflict []string, opts ...UpsertOptionFunc) {
+	upsertOpts := &UpsertOptions{}
+	for _, o := range opts {
+		o(upsertOpts)
+	}
 
 	if upsertOpts.conflictTarget != "" {
 		buf.WriteString(upsertOpts.conflictTarget)
@@ -75,12 +134,16 @@ func buildUpsertQueryPostgres(dia drivers.Dialect, tableName string, updateOnCon
 				buf.WriteString(quoted)
 			}
 		}
-	}
 
-	if len(ret) != 0 {
-		buf.WriteString(" RETURNING ")
-		buf.WriteString(strings.Join(ret, ", "))
+		where := " WHERE "
+		if upsertOpts.versionColumn != "" {
+			quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, upsertOpts.versionColumn)
+			fmt.Fprintf(buf, "%s%s.%s = EXCLUDED.%s - 1", where, upsertOpts.versionTable, quoted, quoted)
+			where = " AND "
+		}
+		if upsertOpts.tenantColumn != "" {
+			quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, upsertOpts.tenantColumn)
+			fmt.Fprintf(buf, "%s%s.%s = EXCLUDED.%s", where, upsertOpts.tenantTable, quoted, quoted)
+		}
 	}
-
-	return buf.String()
 }
@@ -0,0 +1,82 @@
+{{- $alias := .Aliases.Table .Table.Name}}
+func test{{$alias.UpPlural}}CopyIn(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o1 := &{{$alias.UpSingular}}{}
+	o2 := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o1, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, o2, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	slice := {{$alias.UpSingular}}Slice{o1, o2}
+	if err = slice.CopyIn({{if not .NoContext}}ctx, {{end -}} tx); err != nil {
+		t.Errorf("Unable to copy in {{$alias.UpSingular}}: %s", err)
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func test{{$alias.UpPlural}}CopyInConflict(t *testing.T) {
+	t.Parallel()
+
+	if len({{$alias.DownSingular}}AllColumns) == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o1 := &{{$alias.UpSingular}}{}
+	o2 := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o1, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, o2, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	{{- if .Audited .Table.Name}}
+	// The rows it updates aren't audited, it fails without skipping hooks
+	ctx = boil.SkipHooks(ctx)
+	{{- end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	slice := {{$alias.UpSingular}}Slice{o1, o2}
+	if err = slice.CopyInConflict({{if not .NoContext}}ctx, {{end -}} tx, false, nil, boil.Infer()); err != nil {
+		t.Errorf("Unable to copy in {{$alias.UpSingular}} with conflicts: %s", err)
+	}
+
+	// Copying the same rows again updates them instead of inserting new ones
+	if err = randomize.Struct(seed, o1, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, o2, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	if err = slice.CopyInConflict({{if not .NoContext}}ctx, {{end -}} tx, true, nil, boil.Infer()); err != nil {
+		t.Errorf("Unable to copy in {{$alias.UpSingular}} with conflicts: %s", err)
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
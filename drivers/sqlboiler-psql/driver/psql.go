@@ -146,6 +146,7 @@ func (p *PostgresDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo
 			UseIndexPlaceholders: true,
 			UseSchema:            !noOutputSchema,
 			UseDefaultKeyword:    true,
+			UseRowValues:         true,
 		},
 	}
 	dbinfo.Tables, err = drivers.TablesConcurrently(p, schema, whitelist, blacklist, concurrency)
@@ -973,6 +974,7 @@ func (p PostgresDriver) Imports() (importers.Collection, error) {
 	col.Singleton = importers.Map{
 		"psql_upsert": {
 			Standard: importers.List{
+				`"bytes"`,
 				`"fmt"`,
 				`"strings"`,
 			},
@@ -981,6 +983,19 @@ func (p PostgresDriver) Imports() (importers.Collection, error) {
 				`"github.com/aarondl/sqlboiler/v4/drivers"`,
 			},
 		},
+		"psql_copy_in": {
+			Standard: importers.List{
+				`"context"`,
+				`"database/sql"`,
+				`"fmt"`,
+				`"strings"`,
+			},
+			ThirdParty: importers.List{
+				`"github.com/friendsofgo/errors"`,
+				`"github.com/lib/pq"`,
+				`"github.com/aarondl/sqlboiler/v4/boil"`,
+			},
+		},
 	}
 	col.TestSingleton = importers.Map{
 		"psql_suites_test": {
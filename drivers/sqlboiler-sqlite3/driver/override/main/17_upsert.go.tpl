@@ -1,6 +1,12 @@
 {{- if or (not .Table.IsView) .Table.ViewCapabilities.CanUpsert -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $versionCol := .AutoColumns.Version}}
+{{- $versioned := .Table.HasVersion $versionCol}}
+{{- $hasTenant := .Table.HasTenant .AutoColumns.Tenant}}
+{{- $guarded := or $versioned $hasTenant}}
+{{- $conflictErr := "ErrStaleObject"}}
+{{- if not $versioned}}{{$conflictErr = "boil.ErrTenantConflict"}}{{end}}
 {{if .AddGlobal -}}
 // UpsertG attempts an insert, and does an update or ignore on conflict.
 func (o *{{$alias.UpSingular}}) UpsertG({{if not .NoContext}}ctx context.Context, {{end -}} updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
@@ -32,12 +38,28 @@ func (o *{{$alias.UpSingular}}) UpsertP({{if .NoContext}}exec boil.Executor{{els
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+{{- if $versioned}}
+// Conflicting rows are only updated when their {{$versionCol}} is unchanged, which
+// is then incremented. ErrStaleObject is returned when someone else changed the row first.
+{{- end}}
+{{- if $hasTenant}}
+// Conflicting rows of another tenant are never updated, {{$conflictErr}} is
+// returned for them instead.
+{{- end}}
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpsertOperation)
+	{{end -}}
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
 	}
 
 	{{- template "timestamp_upsert_helper" . }}
+	{{- if $hasTenant}}
+	if err := o.setTenant(ctx); err != nil {
+		return err
+	}
+	{{- end}}
 
 	{{if not .NoHooks -}}
 	if err := o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
@@ -45,6 +67,13 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	}
 	{{- end}}
 
+	{{if $versioned -}}
+	version := o.{{$alias.Column $versionCol}}
+	if updateOnConflict {
+		o.{{$alias.Column $versionCol}}++
+	}
+
+	{{end -}}
 	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
 
 	// Build cache key in-line uglily - mysql vs psql problems
@@ -92,11 +121,22 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 			{{$alias.DownSingular}}AllColumns,
 			{{$alias.DownSingular}}PrimaryKeyColumns,
 		)
+		{{- if filterColumnsByAuto true .Table.Columns }}
+		insert = strmangle.SetComplement(insert, strmangle.SetComplement({{$alias.DownSingular}}GeneratedColumns, {{$alias.DownSingular}}PrimaryKeyColumns))
+		update = strmangle.SetComplement(update, {{$alias.DownSingular}}GeneratedColumns)
+		{{- end }}
 
 		if updateOnConflict && len(update) == 0 {
 			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
 		}
 
+		{{- if $versioned}}
+		if len(update) != 0 {
+			insert = strmangle.SetMerge(insert, []string{"{{$versionCol}}"})
+			update = strmangle.SetMerge(update, []string{"{{$versionCol}}"})
+		}
+		{{- end}}
+
 		ret := strmangle.SetComplement({{$alias.DownSingular}}AllColumns, strmangle.SetIntersect(insert, update))
 
 		conflict := conflictColumns
@@ -104,12 +144,13 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 			conflict = make([]string, len({{$alias.DownSingular}}PrimaryKeyColumns))
 			copy(conflict, {{$alias.DownSingular}}PrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQuerySQLite(dialect, "{{$schemaTable}}", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQuerySQLite(dialect, "{{$schemaTable}}", updateOnConflict, ret, update, conflict, insert, "{{if $versioned}}{{$versionCol}}{{end}}", "{{if $hasTenant}}{{.AutoColumns.Tenant}}{{end}}")
 
 		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, insert)
 		if err != nil {
 			return err
 		}
+		cache.columns = insert
 		if len(ret) != 0 {
 			cache.retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, ret)
 			if err != nil {
@@ -128,46 +169,360 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, vals)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", cache.columns, vals))
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
+		fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", cache.columns, vals))
 	}
 	{{end -}}
 
 	if len(cache.retMapping) != 0 {
 		{{if .NoContext -}}
-		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		err = boil.QueryRow(exec, cache.query, vals...).Scan(returns...)
 		{{else -}}
-		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		err = boil.QueryRowContext(boil.WithArgColumns(ctx, cache.columns), exec, cache.query, vals...).Scan(returns...)
 		{{end -}}
 		if errors.Is(err, sql.ErrNoRows) {
+			{{- if $guarded}}
+			if updateOnConflict {
+				{{- if $versioned}}
+				o.{{$alias.Column $versionCol}} = version
+				{{- end}}
+				return {{$conflictErr}}
+			}
+			{{- end}}
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
+		{{if $guarded -}}
+		var result sql.Result
+		{{end -}}
 		{{if .NoContext -}}
-		_, err = exec.Exec(cache.query, vals...)
+		{{if $guarded}}result{{else}}_{{end}}, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-		_, err = exec.ExecContext(ctx, cache.query, vals...)
+		{{if $guarded}}result{{else}}_{{end}}, err = exec.ExecContext(boil.WithArgColumns(ctx, cache.columns), cache.query, vals...)
 		{{end -}}
+		{{- if $guarded}}
+		if err == nil && updateOnConflict {
+			var rowsAff int64
+			if rowsAff, err = result.RowsAffected(); err == nil && rowsAff == 0 {
+				{{- if $versioned}}
+				o.{{$alias.Column $versionCol}} = version
+				{{- end}}
+				return {{$conflictErr}}
+			}
+		}
+		{{- end}}
 	}
 	if err != nil {
+		{{- if $versioned}}
+		o.{{$alias.Column $versionCol}} = version
+		{{- end}}
 		return errors.Wrap(err, "{{.PkgName}}: unable to upsert {{.Table.Name}}")
 	}
 
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
 	if !cached {
 		{{$alias.DownSingular}}UpsertCacheMut.Lock()
 		{{$alias.DownSingular}}UpsertCache[key] = cache
 		{{$alias.DownSingular}}UpsertCacheMut.Unlock()
 	}
 
+	{{if not .Table.IsView -}}
+	o.refreshSnapshot()
+
+	{{end -}}
 	{{if not .NoHooks -}}
 	return o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
 	{{- else -}}
 	return nil
 	{{- end}}
 }
+
+{{if .AddGlobal -}}
+// UpsertAllG attempts to insert all rows in the slice, and does an update or
+// ignore on conflict. See UpsertAll for batching behavior.
+func (o {{$alias.UpSingular}}Slice) UpsertAllG({{if not .NoContext}}ctx context.Context, {{end -}} updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.UpsertAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+{{end -}}
+
+{{if and .AddGlobal .AddPanic -}}
+// UpsertAllGP attempts to insert all rows in the slice, and does an update or
+// ignore on conflict. Panics on error.
+func (o {{$alias.UpSingular}}Slice) UpsertAllGP({{if not .NoContext}}ctx context.Context, {{end -}} updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) {
+	if err := o.UpsertAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, updateOnConflict, conflictColumns, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// UpsertAllP attempts to insert all rows in the slice using an executor, and
+// does an update or ignore on conflict. UpsertAllP panics on error.
+func (o {{$alias.UpSingular}}Slice) UpsertAllP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) {
+	if err := o.UpsertAll({{if not .NoContext}}ctx, {{end -}} exec, updateOnConflict, conflictColumns, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// UpsertAll attempts to insert all rows in the slice using an executor, and
+// does an update or ignore on conflict. The rows are sent in multi-row
+// statements holding as many rows as the parameter limit allows.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+//
+// SQLite updates a row once per conflicting row in the statement, so the
+// last of the rows that conflict with each other wins. Returned values
+// are written back to the rows unless some of them were ignored because of
+// a conflict, since the remaining returned rows can't be matched to them.
+{{- if $versioned}}
+//
+// Conflicting rows are only updated when their {{$versionCol}} is unchanged, which
+// is then incremented. ErrStaleObject is returned when someone else changed
+// one of them first, and the versions of all rows are restored on error. Run
+// it in a transaction so that the rows written before the error are rolled back too.
+{{- end}}
+{{- if $hasTenant}}
+//
+// Conflicting rows of another tenant are never updated, {{$conflictErr}} is
+// returned for them instead.
+{{- end}}
+func (o {{$alias.UpSingular}}Slice) UpsertAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) {{if $versioned}}(err error){{else}}error{{end}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpsertOperation)
+	{{end -}}
+	if len(o) == 0 {
+		return nil
+	}
+
+	type upsertGroup struct {
+		nzDefaults []string
+		rows       {{$alias.UpSingular}}Slice
+	}
+
+	{{if $versioned -}}
+	bumped := 0
+	defer func() {
+		if err != nil {
+			for _, o := range o[:bumped] {
+				o.{{$alias.Column $versionCol}}--
+			}
+		}
+	}()
+
+	{{end -}}
+	var groups []*upsertGroup
+	groupsByKey := make(map[string]*upsertGroup)
+	for _, o := range o {
+		if o == nil {
+			return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
+		}
+
+		{{- template "timestamp_upsert_helper" . }}
+		{{- if $hasTenant}}
+		if err := o.setTenant(ctx); err != nil {
+			return err
+		}
+		{{- end}}
+
+		{{if not .NoHooks -}}
+		if err := o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+		{{- end}}
+
+		{{if $versioned -}}
+		if updateOnConflict {
+			o.{{$alias.Column $versionCol}}++
+			bumped++
+		}
+
+		{{end -}}
+		nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
+		key := makeCacheKey(insertColumns, nzDefaults)
+		group, ok := groupsByKey[key]
+		if !ok {
+			group = &upsertGroup{nzDefaults: nzDefaults}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+		group.rows = append(group.rows, o)
+	}
+
+	// The chunks upserted before one that fails stay upserted
+	defer {{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	for _, group := range groups {
+		insert, _ := insertColumns.InsertColumnSet(
+			{{$alias.DownSingular}}AllColumns,
+			{{$alias.DownSingular}}ColumnsWithDefault,
+			{{$alias.DownSingular}}ColumnsWithoutDefault,
+			group.nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			{{$alias.DownSingular}}AllColumns,
+			{{$alias.DownSingular}}PrimaryKeyColumns,
+		)
+		{{- if filterColumnsByAuto true .Table.Columns }}
+		// An auto increment primary key counts as generated, it's kept so
+		// that rows conflict on it
+		insert = strmangle.SetComplement(insert, strmangle.SetComplement({{$alias.DownSingular}}GeneratedColumns, {{$alias.DownSingular}}PrimaryKeyColumns))
+		update = strmangle.SetComplement(update, {{$alias.DownSingular}}GeneratedColumns)
+		{{- end }}
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
+		}
+
+		{{- if $versioned}}
+		if len(update) != 0 {
+			insert = strmangle.SetMerge(insert, []string{"{{$versionCol}}"})
+			update = strmangle.SetMerge(update, []string{"{{$versionCol}}"})
+		}
+		{{- end}}
+
+		ret := strmangle.SetComplement({{$alias.DownSingular}}AllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len({{$alias.DownSingular}}PrimaryKeyColumns))
+			copy(conflict, {{$alias.DownSingular}}PrimaryKeyColumns)
+		}
+
+		valueMapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, insert)
+		if err != nil {
+			return err
+		}
+		var retMapping []uint64
+		if len(ret) != 0 {
+			retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+
+		rowsPerQuery := 1
+		if len(insert) != 0 {
+			rowsPerQuery = maxQueryParams / len(insert)
+			if rowsPerQuery > maxInsertRows {
+				rowsPerQuery = maxInsertRows
+			}
+		}
+
+		for start := 0; start < len(group.rows); start += rowsPerQuery {
+			end := start + rowsPerQuery
+			if end > len(group.rows) {
+				end = len(group.rows)
+			}
+			chunk := group.rows[start:end]
+
+			query := buildUpsertAllQuerySQLite(dialect, "{{$schemaTable}}", len(chunk), updateOnConflict, ret, update, conflict, insert, "{{if $versioned}}{{$versionCol}}{{end}}", "{{if $hasTenant}}{{.AutoColumns.Tenant}}{{end}}")
+			var vals []interface{}
+			for _, row := range chunk {
+				vals = append(vals, queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(row)), valueMapping)...)
+			}
+
+			{{if .NoContext -}}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, query)
+				fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", insert, vals))
+			}
+			{{else -}}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, query)
+				fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", insert, vals))
+			}
+			{{end -}}
+
+			if len(retMapping) == 0 {
+				{{if .NoContext -}}
+				{{if $guarded}}result{{else}}_{{end}}, err {{if $guarded}}:{{end}}= exec.Exec(query, vals...)
+				{{else -}}
+				{{if $guarded}}result{{else}}_{{end}}, err {{if $guarded}}:{{end}}= exec.ExecContext(boil.WithArgColumns(ctx, insert), query, vals...)
+				{{end -}}
+				if err != nil {
+					return errors.Wrap(err, "{{.PkgName}}: unable to upsert all {{.Table.Name}}")
+				}
+				{{- if $guarded}}
+				if updateOnConflict {
+					rowsAff, err := result.RowsAffected()
+					if err != nil {
+						return errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by upsert all for {{.Table.Name}}")
+					}
+					if rowsAff != int64(len(chunk)) {
+						return {{$conflictErr}}
+					}
+				}
+				{{- end}}
+				continue
+			}
+
+			{{if .NoContext -}}
+			rows, err := exec.Query(query, vals...)
+			{{else -}}
+			rows, err := exec.QueryContext(boil.WithArgColumns(ctx, insert), query, vals...)
+			{{end -}}
+			if err != nil {
+				return errors.Wrap(err, "{{.PkgName}}: unable to upsert all {{.Table.Name}}")
+			}
+
+			var returned []*{{$alias.UpSingular}}
+			for err == nil && rows.Next() {
+				r := &{{$alias.UpSingular}}{}
+				if err = rows.Scan(queries.PtrsFromMapping(reflect.Indirect(reflect.ValueOf(r)), retMapping)...); err == nil {
+					returned = append(returned, r)
+				}
+			}
+			if err == nil {
+				err = rows.Err()
+			}
+			if cerr := rows.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
+			}
+
+			// The returned rows are in the same order as the upserted ones,
+			// but ignored rows are missing from them
+			if len(returned) == len(chunk) {
+				for i, r := range returned {
+					queries.CopyFromMapping(reflect.Indirect(reflect.ValueOf(chunk[i])), reflect.Indirect(reflect.ValueOf(r)), retMapping)
+				}
+			} else if updateOnConflict {
+				{{- if $guarded}}
+				return {{$conflictErr}}
+				{{- else}}
+				return ErrSyncFail
+				{{- end}}
+			}
+		}
+	}
+
+	{{if not .Table.IsView -}}
+	for _, o := range o {
+		o.refreshSnapshot()
+	}
+
+	{{end -}}
+	{{if not .NoHooks -}}
+	for _, o := range o {
+		if err := o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+	}
+
+	{{end -}}
+	return nil
+}
 {{end}}
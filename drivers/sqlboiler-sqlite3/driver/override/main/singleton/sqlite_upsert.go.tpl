@@ -1,5 +1,14 @@
 // buildUpsertQuerySQLite builds a SQL statement string using the upsertData provided.
-func buildUpsertQuerySQLite(dia drivers.Dialect, tableName string, updateOnConflict bool, ret, update, conflict, whitelist []string) string {
+func buildUpsertQuerySQLite(dia drivers.Dialect, tableName string, updateOnConflict bool, ret, update, conflict, whitelist []string, version, tenant string) string {
+	return buildUpsertAllQuerySQLite(dia, tableName, 1, updateOnConflict, ret, update, conflict, whitelist, version, tenant)
+}
+
+// buildUpsertAllQuerySQLite builds a SQL statement string that upserts
+// rows rows at once, it can't be used for more than one row when whitelist
+// is empty. When version isn't empty conflicting rows are only updated
+// if their version is one less than the upserted one, and when tenant
+// isn't empty only if they belong to the same tenant.
+func buildUpsertAllQuerySQLite(dia drivers.Dialect, tableName string, rows int, updateOnConflict bool, ret, update, conflict, whitelist []string, version, tenant string) string {
 	conflict = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, conflict)
 	whitelist = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, whitelist)
 	ret = strmangle.IdentQuoteSlice(dia.LQ, dia.RQ, ret)
@@ -7,20 +16,19 @@ func buildUpsertQuerySQLite(dia drivers.Dialect, tableName string, updateOnConfl
 	buf := strmangle.GetBuffer()
 	defer strmangle.PutBuffer(buf)
 
-	columns := "DEFAULT VALUES"
 	if len(whitelist) != 0 {
-		columns = fmt.Sprintf("(%s) VALUES (%s)",
-			strings.Join(whitelist, ", "),
-			strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), 1, 1))
+		fmt.Fprintf(buf, "INSERT INTO %s (%s) VALUES ", tableName, strings.Join(whitelist, ", "))
+		for i := 0; i < rows; i++ {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			fmt.Fprintf(buf, "(%s)", strmangle.Placeholders(dia.UseIndexPlaceholders, len(whitelist), i*len(whitelist)+1, 1))
+		}
+		buf.WriteString(" ON CONFLICT ")
+	} else {
+		fmt.Fprintf(buf, "INSERT INTO %s DEFAULT VALUES ON CONFLICT ", tableName)
 	}
 
-	fmt.Fprintf(
-		buf,
-		"INSERT INTO %s %s ON CONFLICT ",
-		tableName,
-		columns,
-	)
-
 	if !updateOnConflict || len(update) == 0 {
 		buf.WriteString("DO NOTHING")
 	} else {
@@ -37,6 +45,17 @@ func buildUpsertQuerySQLite(dia drivers.Dialect, tableName string, updateOnConfl
 			buf.WriteString(" = EXCLUDED.")
 			buf.WriteString(quoted)
 		}
+
+		where := " WHERE "
+		if version != "" {
+			quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, version)
+			fmt.Fprintf(buf, "%s%s.%s = EXCLUDED.%s - 1", where, tableName, quoted, quoted)
+			where = " AND "
+		}
+		if tenant != "" {
+			quoted := strmangle.IdentQuote(dia.LQ, dia.RQ, tenant)
+			fmt.Fprintf(buf, "%s%s.%s = EXCLUDED.%s", where, tableName, quoted, quoted)
+		}
 	}
 
 	if len(ret) != 0 {
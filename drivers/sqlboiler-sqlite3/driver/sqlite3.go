@@ -96,6 +96,7 @@ func (s SQLiteDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 			UseSchema:         false,
 			UseDefaultKeyword: true,
 			UseLastInsertID:   false,
+			UseRowValues:      true,
 		},
 	}
 
@@ -89,6 +89,42 @@ func (t Table) CanSoftDelete(deleteColumn string) bool {
 	return false
 }
 
+// HasVersion checks if the table has the version column used for optimistic
+// locking. Unlike the other auto columns it has no default name, and it must
+// be a non-null integer.
+func (t Table) HasVersion(versionColumn string) bool {
+	if versionColumn == "" {
+		return false
+	}
+
+	for _, column := range t.Columns {
+		if column.Name != versionColumn {
+			continue
+		}
+		switch column.Type {
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// HasTenant checks if the table has the column that scopes its rows to a
+// tenant. Like the version column it has no default name.
+func (t Table) HasTenant(tenantColumn string) bool {
+	if tenantColumn == "" {
+		return false
+	}
+
+	for _, column := range t.Columns {
+		if column.Name == tenantColumn {
+			return true
+		}
+	}
+	return false
+}
+
 func TablesHaveNullableEnums(tables []Table) bool {
 	for _, table := range tables {
 		for _, col := range table.Columns {
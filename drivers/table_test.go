@@ -148,3 +148,73 @@ func TestCanSoftDelete(t *testing.T) {
 		}
 	}
 }
+
+func TestHasVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Has     bool
+		Column  string
+		Columns []Column
+	}{
+		{true, "version", []Column{
+			{Name: "version", Type: "int"},
+		}},
+		{true, "lock_version", []Column{
+			{Name: "lock_version", Type: "int64"},
+		}},
+		{false, "version", []Column{
+			{Name: "version", Type: "null.Int"},
+		}},
+		{false, "version", []Column{
+			{Name: "version", Type: "string"},
+		}},
+		{false, "", []Column{
+			{Name: "version", Type: "int"},
+		}},
+		{false, "version", nil},
+	}
+
+	for i, test := range tests {
+		table := Table{
+			Columns: test.Columns,
+		}
+
+		if got := table.HasVersion(test.Column); got != test.Has {
+			t.Errorf("%d) wrong: %t", i, got)
+		}
+	}
+}
+
+func TestHasTenant(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Has     bool
+		Column  string
+		Columns []Column
+	}{
+		{true, "tenant_id", []Column{
+			{Name: "tenant_id", Type: "int64"},
+		}},
+		{true, "tenant_id", []Column{
+			{Name: "tenant_id", Type: "null.String"},
+		}},
+		{false, "tenant_id", []Column{
+			{Name: "id", Type: "int"},
+		}},
+		{false, "", []Column{
+			{Name: "tenant_id", Type: "int"},
+		}},
+	}
+
+	for i, test := range tests {
+		table := Table{
+			Columns: test.Columns,
+		}
+
+		if got := table.HasTenant(test.Column); got != test.Has {
+			t.Errorf("%d) wrong: %t", i, got)
+		}
+	}
+}
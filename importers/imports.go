@@ -194,6 +194,7 @@ func NewDefaultImports() Collection {
 		Standard: List{
 			`"database/sql"`,
 			`"fmt"`,
+			`"iter"`,
 			`"reflect"`,
 			`"strings"`,
 			`"sync"`,
@@ -212,7 +213,7 @@ func NewDefaultImports() Collection {
 	col.Singleton = Map{
 		"boil_queries": {
 			Standard: List{
-				`"regexp"`,
+				`"fmt"`,
 			},
 			ThirdParty: List{
 				`"github.com/aarondl/sqlboiler/v4/drivers"`,
@@ -241,6 +242,7 @@ func NewDefaultImports() Collection {
 		ThirdParty: List{
 			`"github.com/aarondl/sqlboiler/v4/boil"`,
 			`"github.com/aarondl/sqlboiler/v4/queries"`,
+			`"github.com/aarondl/sqlboiler/v4/queries/qm"`,
 			`"github.com/aarondl/randomize"`,
 			`"github.com/aarondl/strmangle"`,
 		},
@@ -312,6 +314,22 @@ func NullableEnumImports() Collection {
 	return col
 }
 
+// TenantImports returns imports collection for the tests of tables with a
+// tenant column.
+func TenantImports() Collection {
+	var col Collection
+
+	col.TestSingleton = Map{
+		"boil_queries_test": {
+			ThirdParty: List{
+				`"github.com/aarondl/randomize"`,
+			},
+		},
+	}
+
+	return col
+}
+
 // AddTypeImports takes a set of imports 'a', a type -> import mapping 'typeMap'
 // and a set of column types that are currently in use and produces a new set
 // including both the old standard/third party, as well as the imports required
@@ -115,6 +115,7 @@ func main() {
 	rootCmd.PersistentFlags().StringP("struct-tag-casing", "", "snake", "Decides the casing for go structure tag names. camel, title or snake (default snake)")
 	rootCmd.PersistentFlags().StringP("relation-tag", "r", "-", "Relationship struct tag name")
 	rootCmd.PersistentFlags().StringSliceP("tag-ignore", "", nil, "List of column names that should have tags values set to '-' (ignored during parsing)")
+	rootCmd.PersistentFlags().StringSliceP("sensitive", "", nil, "List of column names whose values are redacted from debug output and query logs")
 	rootCmd.PersistentFlags().BoolP("strict-verify-mod-version", "", false, "Prevent code generation, if project version of sqlboiler not match with executable")
 
 	// hide flags not recommended for use
@@ -185,17 +186,22 @@ func preRun(cmd *cobra.Command, args []string) error {
 			Toml: withDefaultCase(viper.GetString("struct-tag-cases.toml"), viper.GetString("struct-tag-casing")),
 			Boil: withDefaultCase(viper.GetString("struct-tag-cases.boil"), viper.GetString("struct-tag-casing")),
 		},
-		TagIgnore:    viper.GetStringSlice("tag-ignore"),
-		RelationTag:  viper.GetString("relation-tag"),
-		TemplateDirs: viper.GetStringSlice("templates"),
-		Tags:         viper.GetStringSlice("tag"),
-		Replacements: viper.GetStringSlice("replace"),
-		Aliases:      boilingcore.ConvertAliases(viper.Get("aliases")),
-		TypeReplaces: boilingcore.ConvertTypeReplace(viper.Get("types")),
+		TagIgnore:         viper.GetStringSlice("tag-ignore"),
+		Sensitive:         viper.GetStringSlice("sensitive"),
+		SoftDeleteCascade: viper.GetStringSlice("soft-delete-cascade"),
+		Audit:             viper.GetStringSlice("audit"),
+		RelationTag:       viper.GetString("relation-tag"),
+		TemplateDirs:      viper.GetStringSlice("templates"),
+		Tags:              viper.GetStringSlice("tag"),
+		Replacements:      viper.GetStringSlice("replace"),
+		Aliases:           boilingcore.ConvertAliases(viper.Get("aliases")),
+		TypeReplaces:      boilingcore.ConvertTypeReplace(viper.Get("types")),
 		AutoColumns: boilingcore.AutoColumns{
 			Created: viper.GetString("auto-columns.created"),
 			Updated: viper.GetString("auto-columns.updated"),
 			Deleted: viper.GetString("auto-columns.deleted"),
+			Version: viper.GetString("auto-columns.version"),
+			Tenant:  viper.GetString("auto-columns.tenant"),
 		},
 		Inflections: boilingcore.Inflections{
 			Plural:        viper.GetStringMapString("inflections.plural"),
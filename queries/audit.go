@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// auditColumns are the columns of an audit table that AuditInsert writes to
+var auditColumns = []string{"row_id", "operation", "actor", "changed_at", "diff"}
+
+// AuditRowID returns the row_id the audit rows of the row with the primary
+// key values are recorded with, the value of a single column primary key or
+// a JSON array of the values of a composite one.
+func AuditRowID(pk ...interface{}) string {
+	if len(pk) == 1 {
+		return fmt.Sprint(pk[0])
+	}
+
+	b, err := json.Marshal(pk)
+	if err != nil {
+		return fmt.Sprint(pk...)
+	}
+	return string(b)
+}
+
+// AuditInsert makes a query that records an operation on the row with the
+// primary key values in the audit table, along with the actor of the context,
+// the current time and the changes to the row's columns as a JSON object of
+// the column names to their old and new values.
+func AuditInsert(ctx context.Context, dialect *drivers.Dialect, table string, pk []interface{}, op boil.Operation, changes map[string][2]interface{}) (*Query, error) {
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the changes to audit")
+	}
+
+	var actor interface{}
+	if a, ok := boil.ActorFrom(ctx); ok {
+		actor = a
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		table,
+		strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, auditColumns), ", "),
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(auditColumns), 1, 1),
+	)
+
+	return Raw(query,
+		AuditRowID(pk...),
+		op.String(),
+		actor,
+		time.Now().In(boil.GetLocation()),
+		string(changesJSON),
+	), nil
+}
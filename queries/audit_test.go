@@ -0,0 +1,53 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+func TestAuditRowID(t *testing.T) {
+	t.Parallel()
+
+	if id := AuditRowID(int64(5)); id != "5" {
+		t.Error("want 5, got:", id)
+	}
+	if id := AuditRowID(int64(5), "a"); id != `[5,"a"]` {
+		t.Error(`want [5,"a"], got:`, id)
+	}
+}
+
+func TestAuditInsert(t *testing.T) {
+	t.Parallel()
+
+	ctx := boil.WithActor(context.Background(), "jane")
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	changes := map[string][2]interface{}{"name": {"a", "b"}}
+
+	q, err := AuditInsert(ctx, dialect, `"pilots_audit"`, []interface{}{int64(5)}, boil.UpdateOperation, changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query, args := BuildQuery(q)
+	expect := `INSERT INTO "pilots_audit" ("row_id", "operation", "actor", "changed_at", "diff") VALUES ($1,$2,$3,$4,$5)`
+	if query != expect {
+		t.Errorf("want:\n%s\ngot:\n%s", expect, query)
+	}
+	if len(args) != 5 {
+		t.Fatal("want 5 args, got:", len(args))
+	}
+	if args[0] != "5" || args[1] != "update" || args[2] != "jane" || args[4] != `{"name":["a","b"]}` {
+		t.Errorf("wrong args: %#v", args)
+	}
+
+	q, err = AuditInsert(context.Background(), dialect, `"pilots_audit"`, []interface{}{int64(5)}, boil.DeleteOperation, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, args = BuildQuery(q); args[2] != nil {
+		t.Error("want no actor, got:", args[2])
+	}
+}
@@ -0,0 +1,134 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// queryCache is how long the results of a query are kept in boil's cache,
+// and the tags that invalidate them
+type queryCache struct {
+	ttl  time.Duration
+	tags []string
+}
+
+// SetCache makes the query look its results up in the cache set with
+// boil.SetCache, and store them in it for ttl. The tags are added to the
+// query's tags, see AppendCacheTags.
+func SetCache(q *Query, ttl time.Duration, tags ...string) {
+	q.cache.ttl = ttl
+	AppendCacheTags(q, tags...)
+}
+
+// AppendCacheTags adds tags to the query's cached results, they're removed
+// from the cache when any of the tags is invalidated. Generated models tag
+// their queries with their table.
+func AppendCacheTags(q *Query, tags ...string) {
+	q.cache.tags = append(q.cache.tags, tags...)
+}
+
+// cacheTTL returns how long the results of the query are cached, 0 when
+// they're not. The query's TTL comes before the context's.
+func (q *Query) cacheTTL(ctx context.Context) time.Duration {
+	if q.cache.ttl > 0 {
+		return q.cache.ttl
+	}
+	if ttl, ok := boil.CacheTTLFrom(ctx); ok {
+		return ttl
+	}
+	return 0
+}
+
+// cacheKey returns the key of the query's results in the cache, or "" when
+// the query isn't cached: it has no TTL, there's no cache, it's run in a
+// transaction whose writes the cache doesn't know of, or it locks rows.
+// The key is the built query, so it's built with the tenant of the context.
+func (q *Query) cacheKey(ctx context.Context, exec boil.Executor, structType reflect.Type, bkind bindKind) (string, error) {
+	if boil.GetCache() == nil || q.cacheTTL(ctx) <= 0 || q.forlock != "" || len(q.loadJoined) != 0 {
+		return "", nil
+	}
+	if _, ok := exec.(boil.Transactor); ok {
+		return "", nil
+	}
+	if _, ok := exec.(boil.ContextTransactor); ok {
+		return "", nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	scoped, err := q.scopeTenant(ctx)
+	if err != nil {
+		return "", err
+	}
+	qs, args := BuildQuery(scoped)
+
+	return fmt.Sprintf("%s\x00%d\x00%s\x00%#v", structType, bkind, qs, args), nil
+}
+
+// storeInCache stores copies of the objects bound to obj from index from
+// on under key, without their relationships.
+func (q *Query) storeInCache(ctx context.Context, key string, obj interface{}, bkind bindKind, from int) {
+	val := reflect.Indirect(reflect.ValueOf(obj))
+
+	var rows reflect.Value
+	switch bkind {
+	case kindStruct:
+		rows = reflect.MakeSlice(reflect.SliceOf(val.Type()), 0, 1)
+		rows = reflect.Append(rows, val)
+	case kindSliceStruct:
+		rows = reflect.MakeSlice(val.Type(), 0, val.Len()-from)
+		rows = reflect.AppendSlice(rows, val.Slice(from, val.Len()))
+	case kindPtrSliceStruct:
+		rows = reflect.MakeSlice(reflect.SliceOf(val.Type().Elem().Elem()), 0, val.Len()-from)
+		for i := from; i < val.Len(); i++ {
+			rows = reflect.Append(rows, val.Index(i).Elem())
+		}
+	}
+
+	for i := 0; i < rows.Len(); i++ {
+		r := rows.Index(i).FieldByName(relationshipStructName)
+		if r.IsValid() && r.CanSet() {
+			r.Set(reflect.Zero(r.Type()))
+		}
+	}
+
+	boil.GetCache().Set(key, rows.Interface(), q.cacheTTL(ctx), q.cache.tags)
+}
+
+// bindFromCache binds the objects stored under key to obj like bind does,
+// it returns false when they're not in the cache.
+func bindFromCache(key string, obj interface{}, structType reflect.Type, bkind bindKind) bool {
+	cached, ok := boil.GetCache().Get(key)
+	if !ok {
+		return false
+	}
+	rows := reflect.ValueOf(cached)
+	if rows.Kind() != reflect.Slice || rows.Type().Elem() != structType {
+		return false
+	}
+	if bkind == kindStruct && rows.Len() == 0 {
+		return false
+	}
+
+	// The objects are copied so the cached ones aren't modified through them
+	val := reflect.Indirect(reflect.ValueOf(obj))
+	switch bkind {
+	case kindStruct:
+		val.Set(rows.Index(0))
+	case kindSliceStruct:
+		val.Set(reflect.AppendSlice(val, rows))
+	case kindPtrSliceStruct:
+		for i := 0; i < rows.Len(); i++ {
+			ptr := reflect.New(structType)
+			ptr.Elem().Set(rows.Index(i))
+			val.Set(reflect.Append(val, ptr))
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,148 @@
+package queries
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+type testCached struct {
+	ID   int
+	Name string
+	R    *testCachedR
+}
+
+type testCachedR struct {
+	Name string
+}
+
+func TestBindCache(t *testing.T) {
+	cache := boil.NewLRUCache(10)
+	boil.SetCache(cache)
+	defer boil.SetCache(nil)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	newQuery := func(id int) *Query {
+		q := &Query{dialect: dialect}
+		SetFrom(q, "fun")
+		AppendWhere(q, "id > ?", id)
+		SetCache(q, time.Minute, "fun")
+		return q
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "fun" WHERE \(id > \$1\);`).WithArgs(0).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name"}).
+			AddRow(driver.Value(int64(1)), driver.Value("pat")).
+			AddRow(driver.Value(int64(2)), driver.Value("hat")),
+	)
+	mock.ExpectQuery(`SELECT \* FROM "fun" WHERE \(id > \$1\);`).WithArgs(1).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name"}).AddRow(driver.Value(int64(2)), driver.Value("hat")),
+	)
+
+	var first []*testCached
+	if err := newQuery(0).Bind(context.Background(), db, &first); err != nil {
+		t.Fatal(err)
+	}
+	first[0].Name = "changed"
+	first[0].R = &testCachedR{Name: "loaded"}
+
+	var second []*testCached
+	if err := newQuery(0).Bind(context.Background(), db, &second); err != nil {
+		t.Fatal(err)
+	}
+	if len(second) != 2 || second[0].Name != "pat" || second[1].Name != "hat" {
+		t.Errorf("wrong cached rows: %#v", second)
+	}
+	if second[0] == first[0] || second[0].R != nil {
+		t.Error("the cached rows should be copied")
+	}
+
+	var one testCached
+	if err := newQuery(1).Bind(context.Background(), db, &one); err != nil {
+		t.Fatal(err)
+	}
+	if err := newQuery(1).Bind(context.Background(), db, &one); err != nil {
+		t.Fatal(err)
+	}
+	if one.ID != 2 {
+		t.Error("wrong cached row:", one)
+	}
+
+	if cache.Len() != 2 {
+		t.Error("want 2 cached queries, got:", cache.Len())
+	}
+	boil.InvalidateCache("fun")
+	if cache.Len() != 0 {
+		t.Error("want the cached queries to be invalidated, got:", cache.Len())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBindCacheSkipped(t *testing.T) {
+	cache := boil.NewLRUCache(10)
+	boil.SetCache(cache)
+	defer boil.SetCache(nil)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	rows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "name"}).AddRow(driver.Value(int64(1)), driver.Value("pat"))
+	}
+
+	// Queries without a TTL aren't cached
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(rows())
+	q := &Query{dialect: dialect}
+	SetFrom(q, "fun")
+	var obj testCached
+	if err := q.Bind(context.Background(), db, &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nor are the ones run in transactions
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(rows())
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	q = &Query{dialect: dialect}
+	SetFrom(q, "fun")
+	SetCache(q, time.Minute)
+	if err := q.Bind(context.Background(), tx, &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	// The context's TTL caches queries like Find's
+	mock.ExpectQuery(`select \* from "fun" where "id" = \$1`).WithArgs(1).WillReturnRows(rows())
+	ctx := boil.WithCache(context.Background(), time.Minute)
+	for i := 0; i < 2; i++ {
+		q = Raw(`select * from "fun" where "id" = $1`, 1)
+		if err := q.Bind(ctx, db, &obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if cache.Len() != 1 {
+		t.Error("want only the query with the context's TTL to be cached, got:", cache.Len())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -17,15 +17,35 @@ type loadRelationshipState struct {
 	loaded map[string]struct{}
 	toLoad []string
 	mods   map[string]Applicator
+
+	// count is set when the last relationship of toLoad is counted instead
+	// of loaded, with countMods instead of mods
+	count     bool
+	countMods map[string]Applicator
 }
 
 func (l loadRelationshipState) hasLoaded(depth int) bool {
-	_, ok := l.loaded[l.buildKey(depth)]
+	_, ok := l.loaded[l.loadedKey(depth)]
 	return ok
 }
 
 func (l loadRelationshipState) setLoaded(depth int) {
-	l.loaded[l.buildKey(depth)] = struct{}{}
+	l.loaded[l.loadedKey(depth)] = struct{}{}
+}
+
+// counting checks if the relationship at depth is counted.
+func (l loadRelationshipState) counting(depth int) bool {
+	return l.count && depth == len(l.toLoad)-1
+}
+
+// loadedKey is the key of the relationship at depth in loaded, counts
+// are told apart from the relationships with a suffix that can't be in a
+// relationship's name.
+func (l loadRelationshipState) loadedKey(depth int) string {
+	if l.counting(depth) {
+		return l.buildKey(depth) + "#" + countMethodPrefix
+	}
+	return l.buildKey(depth)
 }
 
 func (l loadRelationshipState) buildKey(depth int) string {
@@ -43,19 +63,21 @@ func (l loadRelationshipState) buildKey(depth int) string {
 	return str
 }
 
-// eagerLoad loads all of the model's relationships
+// eagerLoad loads all of the model's relationships, and then counts the
+// relationships in toCount.
 //
-// toLoad should look like:
+// toLoad and toCount should look like:
 // []string{"Relationship", "Relationship.NestedRelationship"} ... etc
 // obj should be one of:
 // *[]*struct or *struct
 // bkind should reflect what kind of thing it is above
-func eagerLoad(ctx context.Context, exec boil.Executor, toLoad []string, mods map[string]Applicator, obj interface{}, bkind bindKind) error {
+func eagerLoad(ctx context.Context, exec boil.Executor, toLoad []string, mods map[string]Applicator, toCount []string, countMods map[string]Applicator, obj interface{}, bkind bindKind) error {
 	state := loadRelationshipState{
-		ctx:    ctx, // defiant to the end, I know this is frowned upon
-		exec:   exec,
-		loaded: map[string]struct{}{},
-		mods:   mods,
+		ctx:       ctx, // defiant to the end, I know this is frowned upon
+		exec:      exec,
+		loaded:    map[string]struct{}{},
+		mods:      mods,
+		countMods: countMods,
 	}
 	for _, toLoad := range toLoad {
 		state.toLoad = strings.Split(toLoad, ".")
@@ -64,6 +86,14 @@ func eagerLoad(ctx context.Context, exec boil.Executor, toLoad []string, mods ma
 		}
 	}
 
+	state.count = true
+	for _, toCount := range toCount {
+		state.toLoad = strings.Split(toCount, ".")
+		if err := state.loadRelationships(0, obj, bkind); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -92,20 +122,12 @@ func eagerLoad(ctx context.Context, exec boil.Executor, toLoad []string, mods ma
 // we gather all the things up we want to load into, load them, and then move
 // to the next level of the graph.
 func (l loadRelationshipState) loadRelationships(depth int, obj interface{}, bkind bindKind) error {
-	typ := reflect.TypeOf(obj).Elem()
-	if bkind == kindPtrSliceStruct {
-		typ = typ.Elem().Elem()
-	}
-
-	loadingFrom := reflect.ValueOf(obj)
-	if loadingFrom.IsNil() {
+	if reflect.ValueOf(obj).IsNil() {
 		return nil
 	}
 
-	if !l.hasLoaded(depth) {
-		if err := l.callLoadFunction(depth, loadingFrom, typ, bkind); err != nil {
-			return err
-		}
+	if err := l.load(depth, obj, bkind); err != nil {
+		return err
 	}
 
 	// Check if we can stop
@@ -113,35 +135,27 @@ func (l loadRelationshipState) loadRelationships(depth int, obj interface{}, bki
 		return nil
 	}
 
-	// *[]*struct -> []*struct
-	// *struct -> struct
-	loadingFrom = reflect.Indirect(loadingFrom)
-
-	// If it's singular we can just immediately call without looping
-	if bkind == kindStruct {
-		return l.loadRelationshipsRecurse(depth, loadingFrom)
-	}
-
-	// If we were an empty slice to begin with, bail, probably a useless check
-	if loadingFrom.Len() == 0 {
-		return nil
-	}
-
-	// Collect eagerly loaded things to send into next eager load call
-	slice, nextBKind, err := collectLoaded(l.toLoad[depth], loadingFrom)
-	if err != nil {
+	next, nextBKind, err := nextLoad(l.toLoad[depth], obj, bkind)
+	if err != nil || next == nil {
 		return err
 	}
 
-	// If we could collect nothing we're done
-	if slice.Len() == 0 {
+	return l.loadRelationships(depth+1, next, nextBKind)
+}
+
+// load calls the load function of the relationship at depth for obj, unless
+// it was loaded already.
+func (l loadRelationshipState) load(depth int, obj interface{}, bkind bindKind) error {
+	if l.hasLoaded(depth) {
 		return nil
 	}
 
-	ptr := reflect.New(slice.Type())
-	ptr.Elem().Set(slice)
+	typ := reflect.TypeOf(obj).Elem()
+	if bkind == kindPtrSliceStruct {
+		typ = typ.Elem().Elem()
+	}
 
-	return l.loadRelationships(depth+1, ptr.Interface(), nextBKind)
+	return l.callLoadFunction(depth, reflect.ValueOf(obj), typ, bkind)
 }
 
 // callLoadFunction finds the loader struct, finds the method that we need
@@ -154,10 +168,15 @@ func (l loadRelationshipState) callLoadFunction(depth int, loadingFrom reflect.V
 		return errors.Errorf("attempted to load %s but no L struct was found", current)
 	}
 
-	// Attempt to find the LoadRelationshipName function
-	loadMethod, found := ln.Type.MethodByName(loadMethodPrefix + current)
+	// Attempt to find the LoadRelationshipName function, or the
+	// CountRelationshipName function when counting
+	prefix, mods := loadMethodPrefix, l.mods
+	if l.counting(depth) {
+		prefix, mods = countMethodPrefix, l.countMods
+	}
+	loadMethod, found := ln.Type.MethodByName(prefix + current)
 	if !found {
-		return errors.Errorf("could not find %s%s method for eager loading", loadMethodPrefix, current)
+		return errors.Errorf("could not find %s%s method for eager loading", prefix, current)
 	}
 
 	ctxArg := reflect.ValueOf(l.ctx)
@@ -186,7 +205,7 @@ func (l loadRelationshipState) callLoadFunction(depth int, loadingFrom reflect.V
 		methodArgs = append(methodArgs, ctxArg)
 	}
 	methodArgs = append(methodArgs, execArg, reflect.ValueOf(bkind == kindStruct), loadingFrom)
-	if mods, ok := l.mods[l.buildKey(depth)]; ok {
+	if mods, ok := mods[l.buildKey(depth)]; ok {
 		methodArgs = append(methodArgs, reflect.ValueOf(mods))
 	} else {
 		methodArgs = append(methodArgs, applicatorSentinelVal)
@@ -201,19 +220,52 @@ func (l loadRelationshipState) callLoadFunction(depth int, loadingFrom reflect.V
 	return nil
 }
 
-// loadRelationshipsRecurse is a helper function for taking a reflect.Value and
-// Basically calls loadRelationships with: obj.R.EagerLoadedObj
-// Called with an obj of *struct
-func (l loadRelationshipState) loadRelationshipsRecurse(depth int, obj reflect.Value) error {
-	key := l.toLoad[depth]
+// nextLoad returns the objects that were loaded into the key relationship of
+// obj, to load the next level of the graph into. Returns nil when nothing
+// was loaded.
+func nextLoad(key string, obj interface{}, bkind bindKind) (interface{}, bindKind, error) {
+	// *[]*struct -> []*struct
+	// *struct -> struct
+	loadingFrom := reflect.Indirect(reflect.ValueOf(obj))
+
+	// If it's singular we can just immediately return the loaded object
+	if bkind == kindStruct {
+		return loadedObject(key, loadingFrom)
+	}
+
+	// If we were an empty slice to begin with, bail, probably a useless check
+	if loadingFrom.Len() == 0 {
+		return nil, 0, nil
+	}
+
+	// Collect eagerly loaded things to send into next eager load call
+	slice, nextBKind, err := collectLoaded(key, loadingFrom)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// If we could collect nothing we're done
+	if slice.Len() == 0 {
+		return nil, 0, nil
+	}
+
+	ptr := reflect.New(slice.Type())
+	ptr.Elem().Set(slice)
+
+	return ptr.Interface(), nextBKind, nil
+}
+
+// loadedObject returns obj.R.EagerLoadedObj for an obj of struct, as a
+// *struct or a *[]*struct.
+func loadedObject(key string, obj reflect.Value) (interface{}, bindKind, error) {
 	r, err := findRelationshipStruct(obj)
 	if err != nil {
-		return errors.Wrapf(err, "failed to append loaded %s", key)
+		return nil, 0, errors.Wrapf(err, "failed to append loaded %s", key)
 	}
 
 	loadedObject := reflect.Indirect(r).FieldByName(key)
 	if loadedObject.IsNil() {
-		return nil
+		return nil, 0, nil
 	}
 
 	bkind := kindStruct
@@ -228,7 +280,7 @@ func (l loadRelationshipState) loadRelationshipsRecurse(depth int, obj reflect.V
 
 		loadedObject = loadedObject.Addr().Convert(sliceType)
 	}
-	return l.loadRelationships(depth+1, loadedObject.Interface(), bkind)
+	return loadedObject.Interface(), bkind, nil
 }
 
 // collectLoaded traverses the next level of the graph and picks up all
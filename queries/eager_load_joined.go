@@ -0,0 +1,430 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/friendsofgo/errors"
+)
+
+const joinMethodPrefix = "Join"
+
+// JoinedRelationship describes a to-one relationship for joining it into a
+// query, instead of eager loading it with a query of its own. Generated
+// models return it from the Join methods of their L structs, eg:
+//
+//	func (commentL) JoinPost() queries.JoinedRelationship
+type JoinedRelationship struct {
+	// Table is the quoted name of the related table
+	Table string
+	// Column is the column of the model's table that's equal to
+	// ForeignColumn, the column of the related table
+	Column        string
+	ForeignColumn string
+	// Columns are the columns of the related table to select
+	Columns []string
+
+	// SoftDeleteColumn is set when soft deleted related rows are left out
+	SoftDeleteColumn string
+	// TenantColumn is set when the related table is scoped to tenants
+	TenantColumn string
+
+	// Reverse is the field of the related object's R struct that's set to
+	// the object it's joined to, or appended to when it's a slice. It's
+	// empty when no back references are set.
+	Reverse string
+	// AfterSelect runs the after select hooks of a joined object, it's nil
+	// when the related model has no hooks. Models generated without contexts
+	// set AfterSelectNoContext instead.
+	AfterSelect          func(ctx context.Context, exec boil.ContextExecutor, obj interface{}) error
+	AfterSelectNoContext func(exec boil.Executor, obj interface{}) error
+}
+
+// joinedLoad is a relationship of a query's model, or of another joined
+// relationship, that's LEFT JOINed into the query and bound into the R
+// struct of the objects it's joined to.
+type joinedLoad struct {
+	// path is the relationship as passed to qm.LoadJoined, it's the alias
+	// of the joined table and prefixes the aliases of its columns
+	path string
+	// parent is the index of the joined load this one is joined to, -1
+	// when it's a relationship of the bound struct
+	parent int
+
+	rField   int
+	rType    reflect.Type
+	relField int
+	typ      reflect.Type
+	rel      JoinedRelationship
+	mapping  map[string]uint64
+
+	// reverseR and reverseField index the field of the related object's R
+	// struct that points back, reverseField is -1 when there's none
+	reverseR     int
+	reverseRType reflect.Type
+	reverseField int
+}
+
+// joinedLoads are the relationships joined into a query, parents before
+// their children.
+type joinedLoads []joinedLoad
+
+// joinLoads resolves the relationships of the query's LoadJoined mods for
+// the struct type that's bound to, and returns a copy of the query that
+// selects their columns from LEFT JOINs.
+func (q *Query) joinLoads(ctx context.Context, structType reflect.Type) (*Query, joinedLoads, error) {
+	if len(q.from) == 0 {
+		return nil, nil, errors.New("relationships can only be joined into a query with a from clause")
+	}
+
+	loads, err := resolveJoinedLoads(structType, q.loadJoined)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	jq := *q
+	jq.rawSQL = rawSQL{}
+	jq.joins = append([]join(nil), q.joins...)
+
+	stars := writeStars(q)
+	if len(stars) == 0 {
+		return nil, nil, errors.New("failed to find the table to join relationships to in the from clause")
+	}
+	lq, rq := string(q.dialect.LQ), string(q.dialect.RQ)
+	from := strings.TrimSuffix(stars[0], ".*")
+
+	jq.selectCols = make([]string, 0, len(q.selectCols)+1)
+	if len(q.selectCols) == 0 {
+		jq.selectCols = append(jq.selectCols, from+".*")
+	}
+	table := strings.NewReplacer(lq, "", rq, "").Replace(from)
+	for _, col := range q.selectCols {
+		// The columns of the model's table are qualified so they're not
+		// ambiguous, and aliased so they're bound without the table name
+		if rgxIdentifier.MatchString(col) {
+			toks := strings.Split(strings.NewReplacer(`"`, "", lq, "", rq, "").Replace(col), ".")
+			if name := toks[len(toks)-1]; len(toks) == 1 || strings.Join(toks[:len(toks)-1], ".") == table {
+				col = fmt.Sprintf("%s.%s%s%s AS %s%s%s", from, lq, name, rq, lq, name, rq)
+			}
+		}
+		jq.selectCols = append(jq.selectCols, col)
+	}
+
+	for _, load := range loads {
+		alias := lq + load.path + rq
+		parentAlias := from
+		if load.parent >= 0 {
+			parentAlias = lq + loads[load.parent].path + rq
+		}
+
+		for _, col := range load.rel.Columns {
+			jq.selectCols = append(jq.selectCols, fmt.Sprintf("%s.%s%s%s AS %s%s.%s%s", alias, lq, col, rq, lq, load.path, col, rq))
+		}
+
+		clause := fmt.Sprintf("%s AS %s ON %s.%s%s%s = %s.%s%s%s", load.rel.Table, alias,
+			alias, lq, load.rel.ForeignColumn, rq, parentAlias, lq, load.rel.Column, rq)
+		var args []interface{}
+		if load.rel.SoftDeleteColumn != "" {
+			clause += fmt.Sprintf(" AND %s.%s%s%s IS NULL", alias, lq, load.rel.SoftDeleteColumn, rq)
+		}
+		if load.rel.TenantColumn != "" && !q.tenant.all && !boil.IsAllTenants(ctx) {
+			tenant, ok := boil.TenantFrom(ctx)
+			if !ok {
+				return nil, nil, boil.ErrNoTenant
+			}
+			clause += fmt.Sprintf(" AND %s.%s%s%s = ?", alias, lq, load.rel.TenantColumn, rq)
+			args = append(args, tenant)
+		}
+		AppendLeftOuterJoin(&jq, clause, args...)
+	}
+
+	return &jq, loads, nil
+}
+
+// resolveJoinedLoads finds the relationships of the struct type that are
+// joined for the paths passed to qm.LoadJoined.
+func resolveJoinedLoads(structType reflect.Type, toLoad []string) (joinedLoads, error) {
+	var loads joinedLoads
+	indexes := make(map[string]int)
+	for _, toLoad := range toLoad {
+		parent := -1
+		typ := structType
+		pieces := strings.Split(toLoad, ".")
+		for i, name := range pieces {
+			path := strings.Join(pieces[:i+1], ".")
+			if index, ok := indexes[path]; ok {
+				parent, typ = index, loads[index].typ
+				continue
+			}
+
+			load, err := newJoinedLoad(typ, name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to join %s", path)
+			}
+			load.path, load.parent = path, parent
+
+			parent = len(loads)
+			indexes[path] = parent
+			typ = load.typ
+			loads = append(loads, load)
+		}
+	}
+
+	return loads, nil
+}
+
+// newJoinedLoad finds the relationship of the struct type from the Join
+// method of its L struct, and the field of its R struct it's bound to.
+func newJoinedLoad(typ reflect.Type, name string) (joinedLoad, error) {
+	var load joinedLoad
+
+	ln, found := typ.FieldByName(loaderStructName)
+	if !found {
+		return load, errors.Errorf("no L struct was found on %s", typ)
+	}
+	joinMethod, found := ln.Type.MethodByName(joinMethodPrefix + name)
+	if !found {
+		return load, errors.Errorf("could not find %s%s method, only to-one relationships can be joined", joinMethodPrefix, name)
+	}
+
+	rn, found := typ.FieldByName(relationshipStructName)
+	if !found || rn.Type.Kind() != reflect.Ptr {
+		return load, errors.Errorf("no R struct was found on %s", typ)
+	}
+	relField, found := rn.Type.Elem().FieldByName(name)
+	if !found || relField.Type.Kind() != reflect.Ptr || relField.Type.Elem().Kind() != reflect.Struct {
+		return load, errors.Errorf("could not find the %s field of the R struct", name)
+	}
+
+	load.rField = rn.Index[0]
+	load.rType = rn.Type.Elem()
+	load.relField = relField.Index[0]
+	load.typ = relField.Type.Elem()
+	load.rel = joinMethod.Func.Call([]reflect.Value{reflect.Zero(ln.Type)})[0].Interface().(JoinedRelationship)
+	load.mapping = getMappingCache(load.typ).structMap
+
+	load.reverseField = -1
+	if len(load.rel.Reverse) != 0 {
+		rn, found := load.typ.FieldByName(relationshipStructName)
+		if !found || rn.Type.Kind() != reflect.Ptr {
+			return load, errors.Errorf("no R struct was found on %s", load.typ)
+		}
+		reverse, found := rn.Type.Elem().FieldByName(load.rel.Reverse)
+		if !found {
+			return load, errors.Errorf("could not find the %s field of the R struct of %s", load.rel.Reverse, load.typ)
+		}
+		load.reverseR = rn.Index[0]
+		load.reverseRType = rn.Type.Elem()
+		load.reverseField = reverse.Index[0]
+	}
+
+	return load, nil
+}
+
+// joinedColumn is a column of a joined relationship in the results, it's
+// scanned into a pointer to a value of the field so NULLs from rows that
+// were not joined don't fail the scan.
+type joinedColumn struct {
+	index   int
+	load    int
+	mapping uint64
+	isKey   bool
+	value   reflect.Value
+}
+
+// joinedBinder binds the columns of joined relationships.
+type joinedBinder struct {
+	track   bool
+	loads   joinedLoads
+	columns []joinedColumn
+	objects []reflect.Value
+	found   []bool
+}
+
+// binder finds the columns of the joined relationships in the results. The
+// joined objects are snapshotted when track is set.
+func (j joinedLoads) binder(cols []string, track bool) *joinedBinder {
+	b := &joinedBinder{
+		track:   track,
+		loads:   j,
+		objects: make([]reflect.Value, len(j)),
+		found:   make([]bool, len(j)),
+	}
+
+	for i, c := range cols {
+		for l, load := range j {
+			col := strings.TrimPrefix(c, load.path+".")
+			if len(col) == len(c) || strings.Contains(col, ".") {
+				continue
+			}
+			mapping, ok := load.mapping[col]
+			if !ok {
+				continue
+			}
+
+			field := ptrFromMapping(reflect.New(load.typ).Elem(), mapping, false)
+			b.columns = append(b.columns, joinedColumn{
+				index:   i,
+				load:    l,
+				mapping: mapping,
+				isKey:   col == load.rel.ForeignColumn,
+				value:   reflect.New(reflect.PtrTo(field.Type())),
+			})
+		}
+	}
+
+	return b
+}
+
+// pointers replaces the pointers of the joined columns in the pointers the
+// results are scanned into.
+func (b *joinedBinder) pointers(ptrs []interface{}) {
+	for _, c := range b.columns {
+		ptrs[c.index] = c.value.Interface()
+	}
+}
+
+// reset clears the R struct of obj before binding a row to it, for when
+// it's a struct that's bound to again for every row.
+func (b *joinedBinder) reset(obj reflect.Value) {
+	for _, load := range b.loads {
+		if load.parent < 0 {
+			obj.Field(load.rField).Set(reflect.Zero(obj.Field(load.rField).Type()))
+			return
+		}
+	}
+}
+
+// bind sets the relationships joined to obj, an addressable struct, to the
+// objects that were scanned from the results, or nil when a relationship
+// had no row to join.
+func (b *joinedBinder) bind(obj reflect.Value) {
+	for l := range b.loads {
+		b.found[l] = false
+	}
+	for _, c := range b.columns {
+		if c.isKey && !c.value.Elem().IsNil() {
+			b.found[c.load] = true
+		}
+	}
+
+	for l, load := range b.loads {
+		b.objects[l] = reflect.Value{}
+		if b.found[l] {
+			b.objects[l] = reflect.New(load.typ)
+		}
+	}
+
+	for _, c := range b.columns {
+		object := b.objects[c.load]
+		if !object.IsValid() || c.value.Elem().IsNil() {
+			continue
+		}
+		ptrFromMapping(object.Elem(), c.mapping, true).Elem().Set(c.value.Elem().Elem())
+	}
+
+	for l, load := range b.loads {
+		parent := obj
+		if load.parent >= 0 {
+			if !b.objects[load.parent].IsValid() {
+				continue
+			}
+			parent = b.objects[load.parent].Elem()
+		}
+
+		r := parent.Field(load.rField)
+		object := b.objects[l]
+		if !object.IsValid() {
+			if !r.IsNil() {
+				r.Elem().Field(load.relField).Set(reflect.Zero(reflect.PtrTo(load.typ)))
+			}
+			continue
+		}
+
+		if r.IsNil() {
+			r.Set(reflect.New(load.rType))
+		}
+		r.Elem().Field(load.relField).Set(object)
+		if b.track && isTracker(load.typ) {
+			snapshot(object)
+		}
+	}
+}
+
+// afterBind sets the back references of the objects joined to the bound
+// ones, starting at index from of a slice, and runs their after select
+// hooks like eager loading does.
+func (j joinedLoads) afterBind(ctx context.Context, exec boil.Executor, obj interface{}, bkind bindKind, from int) error {
+	var bound []reflect.Value
+	val := reflect.Indirect(reflect.ValueOf(obj))
+	switch bkind {
+	case kindStruct:
+		bound = append(bound, val.Addr())
+	case kindSliceStruct:
+		for i := from; i < val.Len(); i++ {
+			bound = append(bound, val.Index(i).Addr())
+		}
+	case kindPtrSliceStruct:
+		for i := from; i < val.Len(); i++ {
+			bound = append(bound, val.Index(i))
+		}
+	}
+
+	objects := make([][]reflect.Value, len(j))
+	for l, load := range j {
+		parents := bound
+		if load.parent >= 0 {
+			parents = objects[load.parent]
+		}
+
+		for _, parent := range parents {
+			r := parent.Elem().Field(load.rField)
+			if r.IsNil() {
+				continue
+			}
+			object := r.Elem().Field(load.relField)
+			if object.IsNil() {
+				continue
+			}
+			objects[l] = append(objects[l], object)
+
+			if load.reverseField < 0 {
+				continue
+			}
+			reverseR := object.Elem().Field(load.reverseR)
+			if reverseR.IsNil() {
+				reverseR.Set(reflect.New(load.reverseRType))
+			}
+			reverse := reverseR.Elem().Field(load.reverseField)
+			if reverse.Kind() == reflect.Slice {
+				reverse.Set(reflect.Append(reverse, parent))
+			} else {
+				reverse.Set(parent)
+			}
+		}
+	}
+
+	for l, load := range j {
+		for _, object := range objects[l] {
+			var err error
+			switch {
+			case load.rel.AfterSelect != nil && ctx != nil:
+				cexec, ok := exec.(boil.ContextExecutor)
+				if !ok {
+					return errors.Errorf("the after select hooks of %s need a context executor", load.path)
+				}
+				err = load.rel.AfterSelect(ctx, cexec, object.Interface())
+			case load.rel.AfterSelectNoContext != nil:
+				err = load.rel.AfterSelectNoContext(exec, object.Interface())
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
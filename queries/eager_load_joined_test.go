@@ -0,0 +1,179 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+type testJoinedComment struct {
+	ID     int `boil:"id"`
+	PostID int `boil:"post_id"`
+
+	R *testJoinedCommentR `boil:"-"`
+	L testJoinedCommentL  `boil:"-"`
+}
+
+type testJoinedCommentR struct {
+	Post *testJoinedPost
+}
+
+type testJoinedCommentL struct{}
+
+func (testJoinedCommentL) JoinPost() JoinedRelationship {
+	return JoinedRelationship{
+		Table:            `"posts"`,
+		Column:           "post_id",
+		ForeignColumn:    "id",
+		Columns:          []string{"id", "author_id", "title"},
+		SoftDeleteColumn: "deleted_at",
+		Reverse:          "Comments",
+		AfterSelect: func(ctx context.Context, exec boil.ContextExecutor, obj interface{}) error {
+			obj.(*testJoinedPost).Selected = true
+			return nil
+		},
+	}
+}
+
+type testJoinedPost struct {
+	ID       int           `boil:"id"`
+	AuthorID sql.NullInt64 `boil:"author_id"`
+	Title    string        `boil:"title"`
+	Selected bool          `boil:"-"`
+
+	R *testJoinedPostR `boil:"-"`
+	L testJoinedPostL  `boil:"-"`
+}
+
+type testJoinedPostR struct {
+	Author   *testJoinedAuthor
+	Comments []*testJoinedComment
+}
+
+type testJoinedPostL struct{}
+
+func (testJoinedPostL) JoinAuthor() JoinedRelationship {
+	return JoinedRelationship{
+		Table:         `"authors"`,
+		Column:        "author_id",
+		ForeignColumn: "id",
+		Columns:       []string{"id", "name"},
+		TenantColumn:  "tenant_id",
+	}
+}
+
+type testJoinedAuthor struct {
+	ID   int    `boil:"id"`
+	Name string `boil:"name"`
+}
+
+func TestJoinLoads(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{
+		from:       []string{`"comments"`},
+		selectCols: []string{"id", `"comments"."post_id"`},
+		loadJoined: []string{"Post.Author", "Post"},
+		dialect:    &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	if _, _, err := q.joinLoads(context.Background(), reflect.TypeOf(testJoinedComment{})); err == nil {
+		t.Error("want an error without a tenant")
+	}
+
+	ctx := boil.WithAllTenants(context.Background())
+	jq, loads, err := q.joinLoads(ctx, reflect.TypeOf(testJoinedComment{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loads) != 2 || loads[0].path != "Post" || loads[1].path != "Post.Author" || loads[1].parent != 0 {
+		t.Errorf("wrong loads: %#v", loads)
+	}
+
+	query, _ := BuildQuery(jq)
+	expect := `SELECT "comments"."id" AS "id", "comments"."post_id" AS "post_id", ` +
+		`"Post"."id" AS "Post.id", "Post"."author_id" AS "Post.author_id", "Post"."title" AS "Post.title", ` +
+		`"Post.Author"."id" AS "Post.Author.id", "Post.Author"."name" AS "Post.Author.name" FROM "comments" ` +
+		`LEFT JOIN "posts" AS "Post" ON "Post"."id" = "comments"."post_id" AND "Post"."deleted_at" IS NULL ` +
+		`LEFT JOIN "authors" AS "Post.Author" ON "Post.Author"."id" = "Post"."author_id";`
+	if query != expect {
+		t.Errorf("want:\n%s\ngot:\n%s", expect, query)
+	}
+	if len(q.joins) != 0 || len(q.selectCols) != 2 {
+		t.Error("the query was modified")
+	}
+
+	q.loadJoined = []string{"Comments"}
+	if _, _, err := q.joinLoads(ctx, reflect.TypeOf(testJoinedComment{})); err == nil {
+		t.Error("want an error for a relationship that can't be joined")
+	}
+}
+
+func TestBindJoined(t *testing.T) {
+	t.Parallel()
+
+	query := &Query{
+		from:       []string{`"comments"`},
+		loadJoined: []string{"Post.Author"},
+		dialect:    &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cols := []string{"id", "post_id", "Post.id", "Post.author_id", "Post.title", "Post.Author.id", "Post.Author.name"}
+	for i := 0; i < 2; i++ {
+		ret := sqlmock.NewRows(cols)
+		ret.AddRow(driver.Value(int64(1)), driver.Value(int64(5)), driver.Value(int64(5)), driver.Value(int64(3)), driver.Value("hello"), driver.Value(int64(3)), driver.Value("pat"))
+		ret.AddRow(driver.Value(int64(2)), driver.Value(int64(6)), driver.Value(int64(6)), nil, driver.Value("bye"), nil, nil)
+		ret.AddRow(driver.Value(int64(3)), driver.Value(int64(7)), nil, nil, nil, nil, nil)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "comments".*, "Post"."id" AS "Post.id"`)).WillReturnRows(ret)
+	}
+
+	check := func(comments []*testJoinedComment) {
+		t.Helper()
+		if len(comments) != 3 {
+			t.Fatal("want 3 comments, got:", len(comments))
+		}
+		if p := comments[0].R.Post; p == nil || p.ID != 5 || p.Title != "hello" || p.AuthorID.Int64 != 3 || p.R.Author == nil || p.R.Author.Name != "pat" {
+			t.Errorf("wrong first post: %#v", p)
+		}
+		if p := comments[1].R.Post; p == nil || p.ID != 6 || p.AuthorID.Valid || (p.R != nil && p.R.Author != nil) {
+			t.Errorf("wrong second post: %#v", p)
+		}
+		if r := comments[2].R; r != nil && r.Post != nil {
+			t.Errorf("want no third post: %#v", r.Post)
+		}
+
+		for _, c := range comments[:2] {
+			if p := c.R.Post; !p.Selected || len(p.R.Comments) != 1 || p.R.Comments[0] != c {
+				t.Errorf("want the after select hooks run and a back reference: %#v", p)
+			}
+		}
+	}
+
+	var comments []*testJoinedComment
+	if err = query.Bind(boil.WithAllTenants(context.Background()), db, &comments); err != nil {
+		t.Fatal(err)
+	}
+	check(comments)
+
+	var values []testJoinedComment
+	if err = query.Bind(boil.WithAllTenants(context.Background()), db, &values); err != nil {
+		t.Fatal(err)
+	}
+	check([]*testJoinedComment{&values[0], &values[1], &values[2]})
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,197 @@
+package queries
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// loadNode is a relationship to eager load, and the relationships to load
+// from the objects it loads.
+type loadNode struct {
+	// key is the relationship's path from the query's model,
+	// eg: "Pets.Toys"
+	key string
+	// count is set when the relationship is counted instead of loaded
+	count    bool
+	children []*loadNode
+}
+
+// child returns the child node for key, adding it when it doesn't exist.
+func (n *loadNode) child(key string, count bool) *loadNode {
+	for _, c := range n.children {
+		if c.key == key && c.count == count {
+			return c
+		}
+	}
+
+	c := &loadNode{key: key, count: count}
+	n.children = append(n.children, c)
+	return c
+}
+
+// parallelLoad is a relationship to load into obj.
+type parallelLoad struct {
+	node  *loadNode
+	obj   interface{}
+	bkind bindKind
+}
+
+// loadErrors are the errors of relationships that were loaded at the same
+// time, in the order the relationships were given to the query.
+type loadErrors []error
+
+// Error implements error.
+func (e loadErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors for errors.Is and errors.As.
+func (e loadErrors) Unwrap() []error {
+	return e
+}
+
+// eagerLoad loads the query's relationships into obj. Sibling relationships
+// are loaded concurrently when the query has load workers, unless exec can't
+// run queries concurrently (see boil.IsConcurrent): a transaction's
+// connection can only run one query at a time.
+func (q *Query) eagerLoad(ctx context.Context, exec boil.Executor, obj interface{}, bkind bindKind) error {
+	ctx = q.eagerLoadContext(ctx)
+	if q.loadWorkers > 1 && boil.IsConcurrent(exec) {
+		return eagerLoadParallel(ctx, exec, q.load, q.loadMods, q.loadCounts, q.countMods, obj, bkind, q.loadWorkers)
+	}
+
+	return eagerLoad(ctx, exec, q.load, q.loadMods, q.loadCounts, q.countMods, obj, bkind)
+}
+
+// eagerLoadParallel loads the relationships like eagerLoad, but one level
+// of the graph at a time, with the relationships of a level loaded by up to
+// workers goroutines:
+//
+//	Load("Pets.Toys"), Load("Pets.Vets"), Load("Property")
+//
+// loads Pets and Property concurrently, and then Toys and Vets. The counts
+// of toCount are loaded along with the relationships of their level. When any
+// relationship of a level fails to load, the errors of that level are
+// returned and the next one isn't loaded.
+func eagerLoadParallel(ctx context.Context, exec boil.Executor, toLoad []string, mods map[string]Applicator, toCount []string, countMods map[string]Applicator, obj interface{}, bkind bindKind, workers int) error {
+	if reflect.ValueOf(obj).IsNil() {
+		return nil
+	}
+
+	root := &loadNode{}
+	for i, path := range append(toLoad[:len(toLoad):len(toLoad)], toCount...) {
+		node := root
+		pieces := strings.Split(path, ".")
+		for j := range pieces {
+			count := i >= len(toLoad) && j == len(pieces)-1
+			node = node.child(strings.Join(pieces[:j+1], "."), count)
+		}
+	}
+
+	level := make([]parallelLoad, 0, len(root.children))
+	for _, node := range root.children {
+		level = append(level, parallelLoad{node: node, obj: obj, bkind: bkind})
+	}
+
+	for len(level) != 0 {
+		// Sibling load functions would both set the R structs of the objects
+		// they load into when they're nil
+		for _, load := range level {
+			newRelationshipStructs(load.obj, load.bkind)
+		}
+
+		errs := make([]error, len(level))
+		sem := make(chan struct{}, workers)
+		var wg sync.WaitGroup
+		for i, load := range level {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int, load parallelLoad) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				state := loadRelationshipState{
+					ctx:       ctx,
+					exec:      exec,
+					loaded:    map[string]struct{}{},
+					toLoad:    strings.Split(load.node.key, "."),
+					mods:      mods,
+					count:     load.node.count,
+					countMods: countMods,
+				}
+				errs[i] = state.load(len(state.toLoad)-1, load.obj, load.bkind)
+			}(i, load)
+		}
+		wg.Wait()
+
+		var failed loadErrors
+		for _, err := range errs {
+			if err != nil {
+				failed = append(failed, err)
+			}
+		}
+		switch len(failed) {
+		case 0:
+		case 1:
+			return failed[0]
+		default:
+			return failed
+		}
+
+		var next []parallelLoad
+		for _, load := range level {
+			if len(load.node.children) == 0 {
+				continue
+			}
+
+			pieces := strings.Split(load.node.key, ".")
+			loaded, loadedBKind, err := nextLoad(pieces[len(pieces)-1], load.obj, load.bkind)
+			if err != nil {
+				return err
+			}
+			if loaded == nil {
+				continue
+			}
+
+			for _, node := range load.node.children {
+				next = append(next, parallelLoad{node: node, obj: loaded, bkind: loadedBKind})
+			}
+		}
+		level = next
+	}
+
+	return nil
+}
+
+// newRelationshipStructs sets the R struct of the objects in obj, a *struct
+// or *[]*struct, that don't have one.
+func newRelationshipStructs(obj interface{}, bkind bindKind) {
+	val := reflect.Indirect(reflect.ValueOf(obj))
+
+	objs := []reflect.Value{val}
+	if bkind == kindPtrSliceStruct {
+		objs = make([]reflect.Value, 0, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			if elem := val.Index(i); !elem.IsNil() {
+				objs = append(objs, elem.Elem())
+			}
+		}
+	}
+
+	for _, o := range objs {
+		r := o.FieldByName(relationshipStructName)
+		if r.IsValid() && r.Kind() == reflect.Ptr && r.IsNil() && r.CanSet() {
+			r.Set(reflect.New(r.Type().Elem()))
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package queries
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+var (
+	errTestParallelBroken = errors.New("broken")
+
+	// testParallelSiblings is waited on by the loaders of the first level
+	// until they were all called, when it's set
+	testParallelSiblings *sync.WaitGroup
+)
+
+type testParallel struct {
+	ID int
+	R  *testParallelR
+	L  testParallelL
+}
+type testParallelR struct {
+	Pets     []*testParallelChild
+	Property *testParallelChild
+	Broken   *testParallelChild
+}
+type testParallelL struct {
+}
+
+type testParallelChild struct {
+	ID int
+	R  *testParallelChildR
+	L  testParallelChildL
+}
+type testParallelChildR struct {
+	Toys []*testParallelChild
+	Vets []*testParallelChild
+}
+type testParallelChildL struct {
+}
+
+func testParallelWait() error {
+	if testParallelSiblings == nil {
+		return nil
+	}
+
+	testParallelSiblings.Done()
+	done := make(chan struct{})
+	go func() {
+		testParallelSiblings.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(time.Second):
+		return errors.New("siblings were not loaded concurrently")
+	}
+}
+
+func testParallelObjs(obj interface{}) []*testParallel {
+	if o, ok := obj.(*testParallel); ok {
+		return []*testParallel{o}
+	}
+	return *obj.(*[]*testParallel)
+}
+
+func testParallelChildren(obj interface{}) []*testParallelChild {
+	if o, ok := obj.(*testParallelChild); ok {
+		return []*testParallelChild{o}
+	}
+	return *obj.(*[]*testParallelChild)
+}
+
+func (testParallelL) LoadPets(_ boil.Executor, singular bool, obj interface{}, mods Applicator) error {
+	if err := testParallelWait(); err != nil {
+		return err
+	}
+	for _, o := range testParallelObjs(obj) {
+		o.R.Pets = []*testParallelChild{{ID: o.ID*10 + 1}, {ID: o.ID*10 + 2}}
+	}
+	return nil
+}
+
+func (testParallelL) LoadProperty(_ boil.Executor, singular bool, obj interface{}, mods Applicator) error {
+	if err := testParallelWait(); err != nil {
+		return err
+	}
+	for _, o := range testParallelObjs(obj) {
+		o.R.Property = &testParallelChild{ID: o.ID * 100}
+	}
+	return nil
+}
+
+func (testParallelL) LoadBroken(_ boil.Executor, singular bool, obj interface{}, mods Applicator) error {
+	return errTestParallelBroken
+}
+
+func (testParallelChildL) LoadToys(_ boil.Executor, singular bool, obj interface{}, mods Applicator) error {
+	for _, o := range testParallelChildren(obj) {
+		if o.R == nil {
+			o.R = &testParallelChildR{}
+		}
+		o.R.Toys = []*testParallelChild{{ID: o.ID * 10}}
+	}
+	return nil
+}
+
+func (testParallelChildL) LoadVets(_ boil.Executor, singular bool, obj interface{}, mods Applicator) error {
+	for _, o := range testParallelChildren(obj) {
+		if o.R == nil {
+			o.R = &testParallelChildR{}
+		}
+		o.R.Vets = []*testParallelChild{{ID: o.ID * 20}}
+	}
+	return nil
+}
+
+func TestEagerLoadParallel(t *testing.T) {
+	testParallelSiblings = &sync.WaitGroup{}
+	testParallelSiblings.Add(2)
+	defer func() { testParallelSiblings = nil }()
+
+	slice := []*testParallel{{ID: 1}, {ID: 2}}
+	toLoad := []string{"Pets.Toys", "Pets.Vets", "Property"}
+	if err := eagerLoadParallel(nil, nil, toLoad, nil, nil, nil, &slice, kindPtrSliceStruct, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, o := range slice {
+		if len(o.R.Pets) != 2 {
+			t.Fatalf("%d: pets were not loaded: %#v", o.ID, o.R)
+		}
+		if o.R.Property == nil || o.R.Property.ID != o.ID*100 {
+			t.Errorf("%d: property was not loaded: %#v", o.ID, o.R.Property)
+		}
+		for _, pet := range o.R.Pets {
+			if len(pet.R.Toys) != 1 || pet.R.Toys[0].ID != pet.ID*10 {
+				t.Errorf("%d: toys were not loaded: %#v", pet.ID, pet.R.Toys)
+			}
+			if len(pet.R.Vets) != 1 || pet.R.Vets[0].ID != pet.ID*20 {
+				t.Errorf("%d: vets were not loaded: %#v", pet.ID, pet.R.Vets)
+			}
+		}
+	}
+}
+
+func TestEagerLoadParallelOne(t *testing.T) {
+	obj := &testParallel{ID: 3}
+	if err := eagerLoadParallel(nil, nil, []string{"Property", "Pets.Toys"}, nil, nil, nil, obj, kindStruct, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	if obj.R.Property == nil || obj.R.Property.ID != 300 {
+		t.Errorf("property was not loaded: %#v", obj.R.Property)
+	}
+	if len(obj.R.Pets) != 2 || len(obj.R.Pets[1].R.Toys) != 1 {
+		t.Errorf("pets were not loaded: %#v", obj.R.Pets)
+	}
+}
+
+func TestEagerLoadParallelErrors(t *testing.T) {
+	want := "failed to eager load Broken: broken; could not find LoadMissing method for eager loading"
+
+	for i := 0; i < 10; i++ {
+		slice := []*testParallel{{ID: 1}}
+		err := eagerLoadParallel(nil, nil, []string{"Broken", "Property", "Missing.Toys"}, nil, nil, nil, &slice, kindPtrSliceStruct, 3)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if err.Error() != want {
+			t.Fatalf("want: %s\ngot:  %s", want, err)
+		}
+		if !errors.Is(err, errTestParallelBroken) {
+			t.Error("expected the error of the relationship to be wrapped")
+		}
+	}
+
+	slice := []*testParallel{{ID: 1}}
+	err := eagerLoadParallel(nil, nil, []string{"Property", "Broken.Toys"}, nil, nil, nil, &slice, kindPtrSliceStruct, 2)
+	if !errors.Is(err, errTestParallelBroken) {
+		t.Errorf("expected only the broken relationship's error, got: %v", err)
+	}
+	if _, ok := err.(loadErrors); ok {
+		t.Error("a single error should not be wrapped in loadErrors")
+	}
+}
@@ -26,6 +26,8 @@ type testEagerR struct {
 	ChildMany []*testEagerChild
 	ZeroOne   *testEagerZero
 	ZeroMany  []*testEagerZero
+
+	counts map[string]int64
 }
 type testEagerL struct {
 }
@@ -38,6 +40,8 @@ type testEagerChild struct {
 type testEagerChildR struct {
 	NestedOne  *testEagerNested
 	NestedMany []*testEagerNested
+
+	counts map[string]int64
 }
 type testEagerChildL struct {
 }
@@ -150,6 +154,42 @@ func (testEagerChildL) LoadNestedMany(_ boil.Executor, singular bool, obj interf
 	return nil
 }
 
+func (testEagerL) CountChildMany(_ boil.Executor, singular bool, obj interface{}, mods Applicator) error {
+	var toSetOn []*testEager
+	if singular {
+		toSetOn = []*testEager{obj.(*testEager)}
+	} else {
+		toSetOn = *obj.(*[]*testEager)
+	}
+
+	for _, o := range toSetOn {
+		if o.R == nil {
+			o.R = &testEagerR{}
+		}
+		o.R.counts = map[string]int64{"ChildMany": 2}
+	}
+
+	return nil
+}
+
+func (testEagerChildL) CountNestedMany(_ boil.Executor, singular bool, obj interface{}, mods Applicator) error {
+	var toSetOn []*testEagerChild
+	if singular {
+		toSetOn = []*testEagerChild{obj.(*testEagerChild)}
+	} else {
+		toSetOn = *obj.(*[]*testEagerChild)
+	}
+
+	for _, o := range toSetOn {
+		if o.R == nil {
+			o.R = &testEagerChildR{}
+		}
+		o.R.counts = map[string]int64{"NestedMany": 2}
+	}
+
+	return nil
+}
+
 func (testEagerL) LoadZeroOne(_ boil.Executor, singular bool, obj interface{}, mods Applicator) error {
 	var toSetOn []*testEager
 	if singular {
@@ -201,7 +241,7 @@ func TestEagerLoadFromOne(t *testing.T) {
 	obj := &testEager{}
 
 	toLoad := []string{"ChildOne.NestedMany", "ChildOne.NestedOne", "ChildMany.NestedMany", "ChildMany.NestedOne"}
-	err := eagerLoad(nil, nil, toLoad, nil, obj, kindStruct)
+	err := eagerLoad(nil, nil, toLoad, nil, nil, nil, obj, kindStruct)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -243,7 +283,7 @@ func TestEagerLoadFromMany(t *testing.T) {
 	}
 
 	toLoad := []string{"ChildOne.NestedMany", "ChildOne.NestedOne", "ChildMany.NestedMany", "ChildMany.NestedOne"}
-	err := eagerLoad(nil, nil, toLoad, nil, &slice, kindPtrSliceStruct)
+	err := eagerLoad(nil, nil, toLoad, nil, nil, nil, &slice, kindPtrSliceStruct)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -281,13 +321,51 @@ func TestEagerLoadFromMany(t *testing.T) {
 	checkNestedMany(slice[1].R.ChildMany[1].R.NestedMany)
 }
 
+func TestEagerLoadCounts(t *testing.T) {
+	testEagerCounters.ChildOne = 0
+	testEagerCounters.ChildMany = 0
+	testEagerCounters.NestedOne = 0
+	testEagerCounters.NestedMany = 0
+
+	obj := []*testEager{
+		&testEager{},
+		&testEager{},
+	}
+
+	toLoad := []string{"ChildOne"}
+	toCount := []string{"ChildMany", "ChildOne.NestedMany"}
+	err := eagerLoad(nil, nil, toLoad, nil, toCount, nil, &obj, kindPtrSliceStruct)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if testEagerCounters.ChildOne != 1 {
+		t.Error("ChildOne should have been loaded once, got:", testEagerCounters.ChildOne)
+	}
+	if testEagerCounters.ChildMany != 0 || testEagerCounters.NestedMany != 0 {
+		t.Error("counted relationships should not have been loaded")
+	}
+
+	for _, o := range obj {
+		if o.R.counts["ChildMany"] != 2 {
+			t.Error("wrong ChildMany count:", o.R.counts["ChildMany"])
+		}
+		if o.R.ChildMany != nil {
+			t.Error("ChildMany should not have been loaded")
+		}
+		if o.R.ChildOne.R.counts["NestedMany"] != 2 {
+			t.Error("wrong NestedMany count:", o.R.ChildOne.R.counts["NestedMany"])
+		}
+	}
+}
+
 func TestEagerLoadZeroParents(t *testing.T) {
 	t.Parallel()
 
 	obj := &testEager{}
 
 	toLoad := []string{"ZeroMany.NestedMany", "ZeroOne.NestedOne", "ZeroMany.NestedMany", "ZeroOne.NestedOne"}
-	err := eagerLoad(nil, nil, toLoad, nil, obj, kindStruct)
+	err := eagerLoad(nil, nil, toLoad, nil, nil, nil, obj, kindStruct)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -309,7 +387,7 @@ func TestEagerLoadZeroParentsMany(t *testing.T) {
 	}
 
 	toLoad := []string{"ZeroMany.NestedMany", "ZeroOne.NestedOne", "ZeroMany.NestedMany", "ZeroOne.NestedOne"}
-	err := eagerLoad(nil, nil, toLoad, nil, &obj, kindPtrSliceStruct)
+	err := eagerLoad(nil, nil, toLoad, nil, nil, nil, &obj, kindPtrSliceStruct)
 	if err != nil {
 		t.Fatal(err)
 	}
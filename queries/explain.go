@@ -0,0 +1,342 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+	"github.com/friendsofgo/errors"
+)
+
+// ExplainOptions changes how Explain asks the database for a query plan
+type ExplainOptions struct {
+	// Analyze executes the query and adds the actual row counts and timings
+	// to the plan. It's only supported by PostgreSQL. Since the query is run
+	// a data modifying query should be explained in a transaction that's
+	// rolled back afterwards.
+	Analyze bool
+}
+
+// Plan is the query plan the database chose for a query
+type Plan struct {
+	// Nodes are the top level steps of the plan
+	Nodes []*PlanNode
+	// Raw is the JSON document the plan was parsed from, it's empty for
+	// SQLite which returns the plan as rows.
+	Raw string
+}
+
+// PlanNode is a single step in a query plan
+type PlanNode struct {
+	// Detail is a one line description of the step
+	Detail string
+	// Info holds the properties the database reported for the step
+	// other than its children, as decoded from JSON.
+	Info map[string]interface{}
+	// Children are the steps feeding into this step
+	Children []*PlanNode
+}
+
+// String renders the plan as an indented tree, one step per line
+func (p *Plan) String() string {
+	buf := &strings.Builder{}
+	for _, n := range p.Nodes {
+		n.write(buf, 0)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func (n *PlanNode) write(buf *strings.Builder, depth int) {
+	if depth > 0 {
+		buf.WriteString(strings.Repeat("  ", depth-1))
+		buf.WriteString("-> ")
+	}
+	buf.WriteString(n.Detail)
+	buf.WriteByte('\n')
+	for _, c := range n.Children {
+		c.write(buf, depth+1)
+	}
+}
+
+type explainFlavor int
+
+const (
+	explainPostgres explainFlavor = iota
+	explainMySQL
+	explainSQLite
+)
+
+// explainFlavorOf tells the databases apart by their dialects, which have
+// no name.
+func explainFlavorOf(d *drivers.Dialect) (explainFlavor, error) {
+	switch {
+	case d == nil:
+		return 0, errors.New("explain needs the dialect of the query")
+	case d.UseTopClause:
+		return 0, errors.New("explain is not supported for mssql")
+	case d.LQ == '`':
+		return explainMySQL, nil
+	case d.UseIndexPlaceholders:
+		return explainPostgres, nil
+	default:
+		return explainSQLite, nil
+	}
+}
+
+// Explain asks the database how it executes the query and returns the parsed
+// plan. It runs EXPLAIN (FORMAT JSON) on PostgreSQL, EXPLAIN FORMAT=JSON on
+// MySQL and EXPLAIN QUERY PLAN on SQLite.
+//
+// Like Bind the context may be nil, in which case exec is used without one.
+func Explain(ctx context.Context, exec boil.Executor, q *Query, opts ExplainOptions) (*Plan, error) {
+	flavor, err := explainFlavorOf(q.dialect)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Analyze && flavor != explainPostgres {
+		return nil, errors.New("explain analyze is only supported for postgres")
+	}
+
+	scopeCtx := ctx
+	if scopeCtx == nil {
+		scopeCtx = context.Background()
+	}
+	scoped, err := q.scopeTenant(scopeCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	qs, args := BuildQuery(scoped)
+	switch flavor {
+	case explainPostgres:
+		if opts.Analyze {
+			qs = "EXPLAIN (ANALYZE, FORMAT JSON) " + qs
+		} else {
+			qs = "EXPLAIN (FORMAT JSON) " + qs
+		}
+	case explainMySQL:
+		qs = "EXPLAIN FORMAT=JSON " + qs
+	case explainSQLite:
+		qs = "EXPLAIN QUERY PLAN " + qs
+	}
+
+	var rows *sql.Rows
+	if ctx != nil {
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, qs)
+			fmt.Fprintln(writer, boil.RedactArgs(qs, args))
+		}
+		rows, err = exec.(boil.ContextExecutor).QueryContext(ctx, qs, args...)
+	} else {
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, qs)
+			fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(qs, args))
+		}
+		rows, err = exec.Query(qs, args...)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to explain query")
+	}
+	defer rows.Close()
+
+	var plan *Plan
+	if flavor == explainSQLite {
+		plan, err = sqlitePlan(rows)
+	} else {
+		plan, err = jsonPlan(rows, flavor)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read query plan")
+	}
+
+	return plan, nil
+}
+
+// sqlitePlan builds the plan tree from the id, parent, notused and detail
+// columns returned by EXPLAIN QUERY PLAN.
+func sqlitePlan(rows *sql.Rows) (*Plan, error) {
+	plan := &Plan{}
+	nodes := make(map[int64]*PlanNode)
+	for rows.Next() {
+		var id, parent, notUsed int64
+		var detail string
+		if err := rows.Scan(&id, &parent, &notUsed, &detail); err != nil {
+			return nil, err
+		}
+
+		node := &PlanNode{
+			Detail: detail,
+			Info:   map[string]interface{}{"id": id, "parent": parent, "detail": detail},
+		}
+		nodes[id] = node
+		if p, ok := nodes[parent]; ok {
+			p.Children = append(p.Children, node)
+		} else {
+			plan.Nodes = append(plan.Nodes, node)
+		}
+	}
+
+	return plan, rows.Err()
+}
+
+// jsonPlan reads the single JSON document returned by postgres and mysql
+func jsonPlan(rows *sql.Rows, flavor explainFlavor) (*Plan, error) {
+	var raw string
+	for rows.Next() {
+		if err := rows.Scan(&raw); err != nil {
+			return nil, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return parseJSONPlan(raw, flavor)
+}
+
+// parseJSONPlan builds the plan tree from a postgres or mysql JSON plan
+func parseJSONPlan(raw string, flavor explainFlavor) (*Plan, error) {
+	plan := &Plan{Raw: raw}
+	if flavor == explainPostgres {
+		var doc []map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+			return nil, err
+		}
+		for _, stmt := range doc {
+			if p, ok := stmt["Plan"].(map[string]interface{}); ok {
+				plan.Nodes = append(plan.Nodes, postgresPlanNode(p))
+			}
+		}
+		return plan, nil
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		return nil, err
+	}
+	for _, k := range sortedKeys(doc) {
+		if obj, ok := doc[k].(map[string]interface{}); ok {
+			plan.Nodes = append(plan.Nodes, mysqlPlanNode(k, obj))
+		}
+	}
+	return plan, nil
+}
+
+// postgresPlanNode converts a postgres plan node and its "Plans" children,
+// the detail is written like postgres' own text format.
+func postgresPlanNode(obj map[string]interface{}) *PlanNode {
+	node := &PlanNode{Info: make(map[string]interface{}, len(obj))}
+
+	detail := &strings.Builder{}
+	fmt.Fprint(detail, obj["Node Type"])
+	if rel, ok := obj["Relation Name"]; ok {
+		fmt.Fprintf(detail, " on %v", rel)
+		if alias, ok := obj["Alias"]; ok && alias != rel {
+			fmt.Fprintf(detail, " %v", alias)
+		}
+	}
+	if cost, ok := obj["Total Cost"].(float64); ok {
+		startup, _ := obj["Startup Cost"].(float64)
+		fmt.Fprintf(detail, "  (cost=%.2f..%.2f rows=%v width=%v)", startup, cost, obj["Plan Rows"], obj["Plan Width"])
+	}
+	if total, ok := obj["Actual Total Time"].(float64); ok {
+		startup, _ := obj["Actual Startup Time"].(float64)
+		fmt.Fprintf(detail, " (actual time=%.3f..%.3f rows=%v loops=%v)", startup, total, obj["Actual Rows"], obj["Actual Loops"])
+	}
+	node.Detail = detail.String()
+
+	for k, v := range obj {
+		if k != "Plans" {
+			node.Info[k] = v
+		}
+	}
+	children, _ := obj["Plans"].([]interface{})
+	for _, c := range children {
+		if c, ok := c.(map[string]interface{}); ok {
+			node.Children = append(node.Children, postgresPlanNode(c))
+		}
+	}
+
+	return node
+}
+
+// mysqlPlanNode converts an object of mysql's JSON plan. Objects holding
+// other objects or a table are steps of the plan, the rest like cost_info
+// are kept as properties.
+func mysqlPlanNode(name string, obj map[string]interface{}) *PlanNode {
+	node := &PlanNode{Detail: name, Info: make(map[string]interface{})}
+	if table, ok := obj["table_name"]; ok {
+		node.Detail += fmt.Sprintf(" %v", table)
+	}
+	if access, ok := obj["access_type"]; ok {
+		node.Detail += fmt.Sprintf(" (%v)", access)
+	}
+
+	for _, k := range sortedKeys(obj) {
+		switch v := obj[k].(type) {
+		case map[string]interface{}:
+			if isMySQLPlanStep(v) {
+				node.Children = append(node.Children, mysqlPlanNode(k, v))
+			} else {
+				node.Info[k] = v
+			}
+		case []interface{}:
+			var steps []*PlanNode
+			for _, e := range v {
+				e, ok := e.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				// Steps in arrays are usually wrapped like {"table": {...}}
+				if len(e) == 1 {
+					for ek, ev := range e {
+						if ev, ok := ev.(map[string]interface{}); ok {
+							steps = append(steps, mysqlPlanNode(ek, ev))
+							e = nil
+						}
+					}
+				}
+				if e != nil {
+					steps = append(steps, mysqlPlanNode(k, e))
+				}
+			}
+			if len(steps) == 0 {
+				node.Info[k] = v
+			} else {
+				node.Children = append(node.Children, &PlanNode{Detail: k, Info: map[string]interface{}{}, Children: steps})
+			}
+		default:
+			node.Info[k] = v
+		}
+	}
+
+	return node
+}
+
+func isMySQLPlanStep(obj map[string]interface{}) bool {
+	if _, ok := obj["table_name"]; ok {
+		return true
+	}
+	for _, v := range obj {
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,102 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+func TestExplainFlavorOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dialect *drivers.Dialect
+		flavor  explainFlavor
+		err     bool
+	}{
+		{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}, flavor: explainPostgres},
+		{dialect: &drivers.Dialect{LQ: '`', RQ: '`', UseLastInsertID: true}, flavor: explainMySQL},
+		{dialect: &drivers.Dialect{LQ: '"', RQ: '"'}, flavor: explainSQLite},
+		{dialect: &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true}, err: true},
+		{dialect: nil, err: true},
+	}
+
+	for i, test := range tests {
+		flavor, err := explainFlavorOf(test.dialect)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d) expected an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d) %v", i, err)
+		} else if flavor != test.flavor {
+			t.Errorf("%d) want flavor %d, got %d", i, test.flavor, flavor)
+		}
+	}
+}
+
+func TestParseJSONPlanPostgres(t *testing.T) {
+	t.Parallel()
+
+	raw := `[{"Plan": {"Node Type": "Hash Join", "Startup Cost": 1.5, "Total Cost": 30.25, "Plan Rows": 10, "Plan Width": 8,
+		"Plans": [
+			{"Node Type": "Seq Scan", "Relation Name": "videos", "Alias": "v", "Startup Cost": 0, "Total Cost": 20, "Plan Rows": 100, "Plan Width": 8,
+				"Actual Startup Time": 0.01, "Actual Total Time": 0.2, "Actual Rows": 100, "Actual Loops": 1},
+			{"Node Type": "Hash", "Plans": [{"Node Type": "Index Scan", "Relation Name": "users", "Alias": "users"}]}
+		]}, "Planning Time": 0.1}]`
+
+	plan, err := parseJSONPlan(raw, explainPostgres)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Nodes) != 1 || len(plan.Nodes[0].Children) != 2 {
+		t.Fatalf("plan tree was wrong: %#v", plan.Nodes)
+	}
+	if _, ok := plan.Nodes[0].Info["Plans"]; ok {
+		t.Error("children should not be kept in the info")
+	}
+	if plan.Raw != raw {
+		t.Error("raw plan was not kept")
+	}
+
+	want := `Hash Join  (cost=1.50..30.25 rows=10 width=8)
+-> Seq Scan on videos v  (cost=0.00..20.00 rows=100 width=8) (actual time=0.010..0.200 rows=100 loops=1)
+-> Hash
+  -> Index Scan on users`
+	if got := plan.String(); got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestParseJSONPlanMySQL(t *testing.T) {
+	t.Parallel()
+
+	raw := `{"query_block": {"select_id": 1, "cost_info": {"query_cost": "2.40"},
+		"nested_loop": [
+			{"table": {"table_name": "v", "access_type": "ALL", "used_columns": ["id", "user_id"]}},
+			{"table": {"table_name": "u", "access_type": "eq_ref"}}
+		]}}`
+
+	plan, err := parseJSONPlan(raw, explainMySQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Nodes) != 1 {
+		t.Fatalf("plan tree was wrong: %#v", plan.Nodes)
+	}
+	if _, ok := plan.Nodes[0].Info["cost_info"]; !ok {
+		t.Error("cost_info should be kept in the info")
+	}
+
+	want := `query_block
+-> nested_loop
+  -> table v (ALL)
+  -> table u (eq_ref)`
+	if got := plan.String(); got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+}
@@ -0,0 +1,269 @@
+package queries
+
+import (
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+// WhereKind describes what a where clause in a QueryInfo is
+type WhereKind int
+
+// Where kind constants, their order mirrors the internal where kinds
+const (
+	WhereNormal WhereKind = iota
+	WhereLeftParen
+	WhereRightParen
+	WhereIn
+	WhereNotIn
+	WhereSubquery
+)
+
+// SetOpKind describes the set operation of a SetOpInfo
+type SetOpKind int
+
+// Set operation kind constants, their order mirrors the internal set
+// operation kinds
+const (
+	SetOpUnion SetOpKind = iota
+	SetOpUnionAll
+	SetOpIntersect
+	SetOpExcept
+)
+
+// QueryInfo is a read-only snapshot of the state of a Query. It's returned
+// by Inspect and is safe to modify, changes to it do not affect the query.
+type QueryInfo struct {
+	Dialect drivers.Dialect
+
+	// RawSQL is set when the query was created with Raw/SQL or when it has
+	// already been built, in which case it holds the cached sql.
+	RawSQL  string
+	RawArgs []interface{}
+
+	Delete bool
+	Update map[string]interface{}
+	Count  bool
+
+	Withs       []ClauseInfo
+	Select      []string
+	Distinct    string
+	From        []string
+	FromQueries []FromQueryInfo
+	Joins       []JoinInfo
+	Where       []WhereInfo
+	GroupBy     []string
+	OrderBy     []ClauseInfo
+	Having      []ClauseInfo
+	SetOps      []SetOpInfo
+
+	// Limit is nil when the query has no limit
+	Limit   *int
+	Offset  int
+	For     string
+	Comment string
+
+	Load []string
+}
+
+// ClauseInfo is a clause with its arguments
+type ClauseInfo struct {
+	Clause string
+	Args   []interface{}
+}
+
+// JoinInfo is a join clause with its arguments
+type JoinInfo struct {
+	Kind   JoinKind
+	Clause string
+	Args   []interface{}
+}
+
+// FromQueryInfo is a subquery the query selects from, see qm.From
+type FromQueryInfo struct {
+	Query QueryInfo
+	Alias string
+}
+
+// SetOpInfo is a query combined with the query by a set operation, see
+// qm.Union
+type SetOpInfo struct {
+	Kind  SetOpKind
+	Query QueryInfo
+}
+
+// WhereInfo is a single where expression. Or is true when the expression is
+// joined to the previous one with OR instead of AND. Subquery is set for
+// WhereSubquery expressions.
+type WhereInfo struct {
+	Kind     WhereKind
+	Clause   string
+	Args     []interface{}
+	Or       bool
+	Subquery *QueryInfo
+}
+
+// Tables returns the tables the query selects from and joins to, including
+// the ones of its subqueries in the from clause and of the queries combined
+// with it by set operations, without quotes or aliases. Join tables can only
+// be found when the join clause starts with a plain table name.
+func (qi QueryInfo) Tables() []string {
+	quotes := []string{`"`, ""}
+	if qi.Dialect.LQ != 0 {
+		quotes = append(quotes, string(qi.Dialect.LQ), "", string(qi.Dialect.RQ), "")
+	}
+	unquote := strings.NewReplacer(quotes...)
+
+	var tables []string
+	for _, f := range qi.From {
+		if _, name, ok := parseFromClause(strings.Fields(unquote.Replace(f))); ok {
+			tables = append(tables, name)
+		}
+	}
+	for _, f := range qi.FromQueries {
+		tables = append(tables, f.Query.Tables()...)
+	}
+	for _, j := range qi.Joins {
+		if _, name, ok := parseFromClause(strings.Fields(unquote.Replace(j.Clause))); ok {
+			tables = append(tables, name)
+		}
+	}
+	for _, s := range qi.SetOps {
+		tables = append(tables, s.Query.Tables()...)
+	}
+
+	return tables
+}
+
+// Inspect returns a snapshot of the query's current state
+func Inspect(q *Query) QueryInfo {
+	var info QueryInfo
+
+	if q.dialect != nil {
+		info.Dialect = *q.dialect
+	}
+
+	info.RawSQL = q.rawSQL.sql
+	info.RawArgs = copyArgs(q.rawSQL.args)
+
+	info.Delete = q.delete
+	info.Count = q.count
+	if q.update != nil {
+		info.Update = make(map[string]interface{}, len(q.update))
+		for k, v := range q.update {
+			info.Update[k] = v
+		}
+	}
+
+	info.Withs = clauseInfos(q.withs)
+	info.Select = copyStrings(q.selectCols)
+	info.Distinct = q.distinct
+	info.From = copyStrings(q.from)
+	if len(q.fromQueries) != 0 {
+		info.FromQueries = make([]FromQueryInfo, len(q.fromQueries))
+		for i, f := range q.fromQueries {
+			info.FromQueries[i] = FromQueryInfo{Query: Inspect(f.query), Alias: f.alias}
+		}
+	}
+
+	if len(q.joins) != 0 {
+		info.Joins = make([]JoinInfo, len(q.joins))
+		for i, j := range q.joins {
+			info.Joins[i] = JoinInfo{Kind: j.kind, Clause: j.clause, Args: copyArgs(j.args)}
+		}
+	}
+
+	if len(q.where) != 0 {
+		info.Where = make([]WhereInfo, len(q.where))
+		for i, w := range q.where {
+			info.Where[i] = WhereInfo{
+				Kind:   WhereKind(w.kind),
+				Clause: w.clause,
+				Args:   copyArgs(w.args),
+				Or:     w.orSeparator,
+			}
+			if w.query != nil {
+				sub := Inspect(w.query)
+				info.Where[i].Subquery = &sub
+			}
+		}
+	}
+
+	info.GroupBy = copyStrings(q.groupBy)
+	info.OrderBy = clauseInfos(q.orderBy)
+	info.Having = clauseInfos(q.having)
+	if len(q.setOps) != 0 {
+		info.SetOps = make([]SetOpInfo, len(q.setOps))
+		for i, op := range q.setOps {
+			info.SetOps[i] = SetOpInfo{Kind: SetOpKind(op.kind), Query: Inspect(op.query)}
+		}
+	}
+
+	if q.limit != nil {
+		limit := *q.limit
+		info.Limit = &limit
+	}
+	info.Offset = q.offset
+	info.For = q.forlock
+	info.Comment = q.comment
+
+	info.Load = copyStrings(q.load)
+
+	return info
+}
+
+// Rewriter is a function that may modify a query just before it's built
+// into sql, using the Set and Append functions in this package.
+// Rewriters can be used to enforce policies on every query that is run.
+type Rewriter func(q *Query)
+
+var rewriters []Rewriter
+
+// AddRewriter adds a rewriter that is run on every query just before it's
+// built. Rewriters are run in the order they were added, and only once per
+// query. Raw queries are never rewritten. This is not safe to call
+// concurrently with building queries, add rewriters at program start up.
+func AddRewriter(fn Rewriter) {
+	rewriters = append(rewriters, fn)
+}
+
+// runRewriters calls the rewriters on a query that hasn't been built yet
+func (q *Query) runRewriters() {
+	if q.rewritten || len(q.rawSQL.sql) != 0 {
+		return
+	}
+
+	q.rewritten = true
+	for _, fn := range rewriters {
+		fn(q)
+	}
+}
+
+func clauseInfos(clauses []argClause) []ClauseInfo {
+	if len(clauses) == 0 {
+		return nil
+	}
+
+	infos := make([]ClauseInfo, len(clauses))
+	for i, c := range clauses {
+		infos[i] = ClauseInfo{Clause: c.clause, Args: copyArgs(c.args)}
+	}
+
+	return infos
+}
+
+func copyStrings(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	return append([]string(nil), s...)
+}
+
+func copyArgs(args []interface{}) []interface{} {
+	if len(args) == 0 {
+		return nil
+	}
+
+	return append([]interface{}(nil), args...)
+}
@@ -0,0 +1,127 @@
+package queries
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+func TestInspect(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}}
+	SetSelect(q, []string{"id", "name"})
+	SetFrom(q, `"users" as u`)
+	AppendInnerJoin(q, "videos v on v.user_id = u.id and v.kind = ?", 5)
+	AppendWhere(q, "name = ?", "bob")
+	AppendWhere(q, "age > ?", 10)
+	SetLastWhereAsOr(q)
+	AppendIn(q, "id in ?", 1, 2)
+	AppendOrderBy(q, "name")
+	SetLimit(q, 10)
+	SetFor(q, "update")
+	AppendLoad(q, "Videos")
+
+	info := Inspect(q)
+
+	if got := info.Tables(); !reflect.DeepEqual(got, []string{"users", "videos"}) {
+		t.Errorf("tables were wrong: %v", got)
+	}
+	if len(info.Joins) != 1 || info.Joins[0].Kind != JoinInner || !reflect.DeepEqual(info.Joins[0].Args, []interface{}{5}) {
+		t.Errorf("joins were wrong: %#v", info.Joins)
+	}
+
+	where := []WhereInfo{
+		{Kind: WhereNormal, Clause: "name = ?", Args: []interface{}{"bob"}},
+		{Kind: WhereNormal, Clause: "age > ?", Args: []interface{}{10}, Or: true},
+		{Kind: WhereIn, Clause: "id in ?", Args: []interface{}{1, 2}},
+	}
+	if !reflect.DeepEqual(info.Where, where) {
+		t.Errorf("where was wrong: %#v", info.Where)
+	}
+
+	if info.Limit == nil || *info.Limit != 10 {
+		t.Error("limit was wrong:", info.Limit)
+	}
+	if info.For != "update" {
+		t.Error("for was wrong:", info.For)
+	}
+	if !reflect.DeepEqual(info.Select, []string{"id", "name"}) || !reflect.DeepEqual(info.Load, []string{"Videos"}) {
+		t.Errorf("select or load was wrong: %v %v", info.Select, info.Load)
+	}
+
+	// Modifying the info must not leak back into the query
+	info.Select[0] = "secret"
+	info.Where[0].Args[0] = "alice"
+	*info.Limit = 5
+	if q.selectCols[0] != "id" || q.where[0].args[0] != "bob" || *q.limit != 10 {
+		t.Error("inspect shared state with the query")
+	}
+}
+
+func TestInspectTables(t *testing.T) {
+	t.Parallel()
+
+	mysql := &drivers.Dialect{LQ: '`', RQ: '`'}
+	q := &Query{dialect: mysql}
+	SetFrom(q, "`users`")
+	AppendLeftOuterJoin(q, "`videos` on `videos`.`user_id` = `users`.`id`")
+
+	old := &Query{dialect: mysql}
+	SetFrom(old, "`jets`")
+	AppendFromSubquery(q, old, "old_jets")
+
+	admins := &Query{dialect: mysql}
+	SetFrom(admins, "`admins` as `a`")
+	AppendUnionAll(q, admins)
+
+	info := Inspect(q)
+	if got, want := info.Tables(), []string{"users", "jets", "videos", "admins"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tables were wrong, want: %v, got: %v", want, got)
+	}
+	if len(info.FromQueries) != 1 || info.FromQueries[0].Alias != "old_jets" {
+		t.Errorf("from queries were wrong: %#v", info.FromQueries)
+	}
+	if len(info.SetOps) != 1 || info.SetOps[0].Kind != SetOpUnionAll || !reflect.DeepEqual(info.SetOps[0].Query.From, []string{"`admins` as `a`"}) {
+		t.Errorf("set ops were wrong: %#v", info.SetOps)
+	}
+
+	mssql := &Query{dialect: &drivers.Dialect{LQ: '[', RQ: ']'}}
+	SetFrom(mssql, "[dbo].[users]")
+	if got := Inspect(mssql).Tables(); !reflect.DeepEqual(got, []string{"dbo.users"}) {
+		t.Errorf("tables were wrong: %v", got)
+	}
+}
+
+func TestRewriters(t *testing.T) {
+	// Not parallel, rewriters are global
+	defer func() { rewriters = nil }()
+
+	calls := 0
+	AddRewriter(func(q *Query) {
+		calls++
+		if Inspect(q).Delete {
+			AppendWhere(q, "tenant_id = ?", 7)
+		}
+	})
+
+	q := &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}}
+	SetFrom(q, "videos")
+	SetDelete(q)
+	AppendWhere(q, "id = ?", 1)
+
+	sql, args := BuildQuery(q)
+	if want := `DELETE FROM "videos" WHERE (id = $1) AND (tenant_id = $2);`; sql != want {
+		t.Errorf("sql was wrong:\nwant: %s\ngot:  %s", want, sql)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1, 7}) {
+		t.Errorf("args were wrong: %v", args)
+	}
+
+	BuildQuery(q)
+	BuildQuery(Raw("select 1"))
+	if calls != 1 {
+		t.Error("want the rewriter to be called once, got:", calls)
+	}
+}
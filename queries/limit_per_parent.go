@@ -0,0 +1,126 @@
+package queries
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/strmangle"
+)
+
+const (
+	perParentAlias     = "boil_per_parent"
+	perParentRowNumber = "boil_row_number"
+)
+
+// perParentLimit limits the rows of an eager load query to the first rows
+// of every parent they're loaded for
+type perParentLimit struct {
+	limit  int
+	column string
+}
+
+// SetLimitPerParent limits the rows the query selects for every parent to
+// limit, in the order of its ORDER BY. It only applies to the queries that
+// have a parent column, ie: the ones that eager load relationships.
+func SetLimitPerParent(q *Query, limit int) {
+	q.perParent.limit = limit
+}
+
+// SetParentColumn sets the column that relates the rows of the query to
+// their parent, the rows are limited for every value of it when the query
+// has a limit per parent.
+func SetParentColumn(q *Query, column string) {
+	q.perParent.column = column
+}
+
+// hasLimitPerParent checks if the select query limits the rows of every
+// parent.
+func (q *Query) hasLimitPerParent() bool {
+	return q.perParent.limit > 0 && q.perParent.column != "" && !q.count && len(q.setOps) == 0
+}
+
+// writeLimitPerParent writes the query with the rows of every parent
+// numbered in a subquery, and selects the ones numbered up to the limit:
+//
+//	SELECT * FROM (
+//	  SELECT "comments".*, ROW_NUMBER() OVER (PARTITION BY "comments"."post_id" ORDER BY ...) AS "boil_row_number"
+//	  FROM "comments" WHERE ...
+//	) AS "boil_per_parent" WHERE "boil_per_parent"."boil_row_number" <= 3 ORDER BY "boil_per_parent"."boil_row_number"
+//
+// The ORDER BY of the query numbers the rows, its LIMIT, OFFSET and locking
+// clause are left out.
+func writeLimitPerParent(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	alias := strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, perParentAlias)
+	rowNumber := alias + "." + strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, perParentRowNumber)
+
+	buf.WriteString("SELECT ")
+	if cols := perParentColumns(q, alias); len(cols) != 0 {
+		buf.WriteString(strings.Join(cols, ", "))
+	} else {
+		buf.WriteByte('*')
+	}
+	buf.WriteString(" FROM (")
+
+	// The row number is written before the FROM of the subquery, the args of
+	// the ORDER BY come first
+	over := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(over)
+	fmt.Fprintf(over, "ROW_NUMBER() OVER (PARTITION BY %s", q.perParent.column)
+	if len(q.orderBy) != 0 {
+		writeParameterizedModifiers(q, over, args, " ORDER BY ", ", ", q.orderBy)
+	} else {
+		fmt.Fprintf(over, " ORDER BY %s", q.perParent.column)
+	}
+	fmt.Fprintf(over, ") AS %s", strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, perParentRowNumber))
+
+	inner := *q
+	inner.perParent = perParentLimit{}
+	inner.orderBy = nil
+	inner.limit = nil
+	inner.offset = 0
+	inner.forlock = ""
+	inner.selectCols = q.selectCols
+	if len(inner.selectCols) == 0 {
+		inner.selectCols = writeStars(q)
+	}
+	inner.selectCols = append(inner.selectCols[:len(inner.selectCols):len(inner.selectCols)], over.String())
+
+	writeSelect(&inner, buf, args, false)
+	writeModifiers(&inner, buf, args)
+
+	fmt.Fprintf(buf, ") AS %s WHERE %s <= %d ORDER BY %s", alias, rowNumber, q.perParent.limit, rowNumber)
+}
+
+// perParentColumns returns the columns of the query as they're named in the
+// subquery of writeLimitPerParent, to select them without the row number.
+// It returns nil when they can't be known, eg: the query selects *.
+func perParentColumns(q *Query, alias string) []string {
+	if len(q.selectCols) == 0 {
+		return nil
+	}
+
+	unquote := strings.NewReplacer(`"`, "", string(q.dialect.LQ), "", string(q.dialect.RQ), "")
+	cols := make([]string, 0, len(q.selectCols))
+	for _, sel := range q.selectCols {
+		// The columns of a query with joins are aliased with their table
+		// when they're quoted with double quotes, see writeAsStatements
+		aliased := len(q.joins) != 0 && rgxIdentifier.MatchString(sel)
+
+		// A select can be a list of columns, eg: qm.Select("a, b")
+		for _, col := range strings.Split(sel, ",") {
+			col = unquote.Replace(strings.TrimSpace(col))
+			if !rgxIdentifier.MatchString(col) {
+				return nil
+			}
+
+			name := col
+			if !aliased {
+				name = col[strings.LastIndexByte(col, '.')+1:]
+			}
+			cols = append(cols, fmt.Sprintf("%s.%c%s%c", alias, q.dialect.LQ, name, q.dialect.RQ))
+		}
+	}
+
+	return cols
+}
@@ -0,0 +1,119 @@
+package queries
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+func TestBuildLimitPerParentQuery(t *testing.T) {
+	t.Parallel()
+
+	psql := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	mysql := &drivers.Dialect{LQ: '`', RQ: '`'}
+	mssql := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true}
+
+	tests := []struct {
+		dialect *drivers.Dialect
+		build   func(q *Query)
+		sql     string
+		args    []interface{}
+	}{
+		{
+			dialect: psql,
+			build: func(q *Query) {
+				SetParentColumn(q, `"comments"."post_id"`)
+				AppendIn(q, `"comments"."post_id" in ?`, 1, 2)
+				SetLimitPerParent(q, 3)
+			},
+			sql: `SELECT * FROM (SELECT "comments".*, ROW_NUMBER() OVER (PARTITION BY "comments"."post_id" ORDER BY "comments"."post_id") AS "boil_row_number" ` +
+				`FROM "comments" WHERE ("comments"."post_id" IN ($1,$2))) AS "boil_per_parent" ` +
+				`WHERE "boil_per_parent"."boil_row_number" <= 3 ORDER BY "boil_per_parent"."boil_row_number";`,
+			args: []interface{}{1, 2},
+		},
+		{
+			dialect: psql,
+			build: func(q *Query) {
+				SetParentColumn(q, `"comments"."post_id"`)
+				AppendWhere(q, "score > ?", 10)
+				AppendOrderBy(q, "similarity(body, ?) desc", "cats")
+				SetLimit(q, 100)
+				SetLimitPerParent(q, 2)
+			},
+			sql: `SELECT * FROM (SELECT "comments".*, ROW_NUMBER() OVER (PARTITION BY "comments"."post_id" ORDER BY similarity(body, $1) desc) AS "boil_row_number" ` +
+				`FROM "comments" WHERE (score > $2)) AS "boil_per_parent" ` +
+				`WHERE "boil_per_parent"."boil_row_number" <= 2 ORDER BY "boil_per_parent"."boil_row_number";`,
+			args: []interface{}{"cats", 10},
+		},
+		{
+			dialect: psql,
+			build: func(q *Query) {
+				SetSelect(q, []string{`"comments"."id"`, `"comments"."body"`, `"a"."tag_id"`})
+				AppendInnerJoin(q, `"comment_tags" as "a" on "comments"."id" = "a"."comment_id"`)
+				SetParentColumn(q, `"a"."tag_id"`)
+				AppendOrderBy(q, "comments.id desc")
+				SetLimitPerParent(q, 1)
+			},
+			sql: `SELECT "boil_per_parent"."comments.id", "boil_per_parent"."comments.body", "boil_per_parent"."a.tag_id" FROM (` +
+				`SELECT "comments"."id" as "comments.id", "comments"."body" as "comments.body", "a"."tag_id" as "a.tag_id", ` +
+				`ROW_NUMBER() OVER (PARTITION BY "a"."tag_id" ORDER BY comments.id desc) AS "boil_row_number" ` +
+				`FROM "comments" INNER JOIN "comment_tags" as "a" on "comments"."id" = "a"."comment_id") AS "boil_per_parent" ` +
+				`WHERE "boil_per_parent"."boil_row_number" <= 1 ORDER BY "boil_per_parent"."boil_row_number";`,
+		},
+		{
+			dialect: mysql,
+			build: func(q *Query) {
+				SetSelect(q, []string{"`comments`.`id`, `a`.`tag_id`"})
+				AppendInnerJoin(q, "comment_tags as a on comments.id = a.comment_id")
+				SetParentColumn(q, "`a`.`tag_id`")
+				SetLimitPerParent(q, 1)
+			},
+			sql: "SELECT `boil_per_parent`.`id`, `boil_per_parent`.`tag_id` FROM (" +
+				"SELECT `comments`.`id`, `a`.`tag_id`, ROW_NUMBER() OVER (PARTITION BY `a`.`tag_id` ORDER BY `a`.`tag_id`) AS `boil_row_number` " +
+				"FROM `comments` INNER JOIN comment_tags as a on comments.id = a.comment_id) AS `boil_per_parent` " +
+				"WHERE `boil_per_parent`.`boil_row_number` <= 1 ORDER BY `boil_per_parent`.`boil_row_number`;",
+		},
+		{
+			dialect: mssql,
+			build: func(q *Query) {
+				SetSelect(q, []string{"id", "body"})
+				SetParentColumn(q, "[comments].[post_id]")
+				SetLimit(q, 5)
+				SetLimitPerParent(q, 5)
+			},
+			sql: "SELECT [boil_per_parent].[id], [boil_per_parent].[body] FROM (SELECT [id], [body], " +
+				"ROW_NUMBER() OVER (PARTITION BY [comments].[post_id] ORDER BY [comments].[post_id]) AS [boil_row_number] FROM [comments]) AS [boil_per_parent] " +
+				"WHERE [boil_per_parent].[boil_row_number] <= 5 ORDER BY [boil_per_parent].[boil_row_number];",
+		},
+		{
+			dialect: psql,
+			build: func(q *Query) {
+				SetLimitPerParent(q, 3)
+			},
+			sql: `SELECT * FROM "comments";`,
+		},
+		{
+			dialect: psql,
+			build: func(q *Query) {
+				SetParentColumn(q, `"comments"."post_id"`)
+				SetLimitPerParent(q, 3)
+				SetCount(q)
+			},
+			sql: `SELECT COUNT(*) FROM "comments";`,
+		},
+	}
+
+	for i, test := range tests {
+		q := &Query{dialect: test.dialect, from: []string{"comments"}}
+		test.build(q)
+
+		sql, args := BuildQuery(q)
+		if sql != test.sql {
+			t.Errorf("%d) wrong sql:\nwant: %s\ngot:  %s", i, test.sql, sql)
+		}
+		if !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%d) wrong args, want: %#v, got: %#v", i, test.args, args)
+		}
+	}
+}
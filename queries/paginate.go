@@ -0,0 +1,300 @@
+package queries
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// keyset holds the state for keyset (cursor) pagination
+type keyset struct {
+	columns []string
+	cursor  string
+	limit   int
+	before  bool
+
+	// applied is set once ApplyPage has turned the state above
+	// into clauses on the query
+	applied bool
+}
+
+// Cursors are the opaque tokens pointing at the pages on either side of a page
+// returned by keyset pagination. Next is meant to be passed to qm.PageAfter and
+// Prev to qm.PageBefore. An empty cursor means there is no page in that
+// direction.
+type Cursors struct {
+	Next string
+	Prev string
+}
+
+// ApplyPage turns the keyset pagination state set by qm.PageAfter or
+// qm.PageBefore into where, order by and limit clauses on the query.
+//
+// typ and mapping describe the struct the rows are bound to, they are used to
+// decode the cursor values back into their original Go types. defaultCols is
+// used to order the rows when qm.PageBy was not given, typically this is the
+// primary key of the table. The columns must uniquely identify a row. The
+// columns of qm.PageBy can't be any of nullableCols: rows with NULLs would
+// never compare as being on either side of the cursor.
+//
+// The page orders and limits the rows, a query that's already ordered or
+// limited can't be paginated.
+func ApplyPage(q *Query, typ reflect.Type, mapping map[string]uint64, defaultCols, nullableCols []string) error {
+	if q.page == nil {
+		return errors.New("query has no page, use qm.PageAfter or qm.PageBefore")
+	}
+	if q.page.applied {
+		return errors.New("page was already applied to the query")
+	}
+	if q.page.limit <= 0 {
+		return errors.New("page size must be greater than zero")
+	}
+	if len(q.orderBy) != 0 {
+		return errors.New("a paginated query can't have an order by, use qm.PageBy to order the pages")
+	}
+	if q.limit != nil {
+		return errors.New("a paginated query can't have a limit, the page size limits the rows")
+	}
+	for _, c := range q.page.columns {
+		name := pageColumnName(c)
+		for _, nullable := range nullableCols {
+			if name == nullable {
+				return errors.Errorf("page column %q is nullable, rows with a NULL in it can't be paginated", c)
+			}
+		}
+	}
+	if len(q.page.columns) == 0 {
+		q.page.columns = append([]string(nil), defaultCols...)
+	}
+	if len(q.page.columns) == 0 {
+		return errors.New("no columns to paginate by, use qm.PageBy")
+	}
+
+	cols := make([]string, len(q.page.columns))
+	for i, c := range q.page.columns {
+		cols[i] = strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, q.qualifyPageColumn(c))
+	}
+
+	if len(q.page.cursor) != 0 {
+		vals, err := decodeCursor(q.page.cursor, typ, mapping, q.page.columns)
+		if err != nil {
+			return err
+		}
+
+		op := ">"
+		if q.page.before {
+			op = "<"
+		}
+
+		clause, args := keysetWhere(q, cols, op, vals)
+		AppendWhere(q, clause, args...)
+	}
+
+	dir := " ASC"
+	if q.page.before {
+		dir = " DESC"
+	}
+	for _, c := range cols {
+		AppendOrderBy(q, c+dir)
+	}
+
+	// Fetch one extra row to find out if there's another page after this one
+	SetLimit(q, q.page.limit+1)
+	q.page.applied = true
+
+	return nil
+}
+
+// PageCursors trims the rows fetched by a query that ApplyPage was called on
+// down to the requested page size, puts them back into ascending order and
+// returns the cursors for the pages on either side of it.
+//
+// rows must be a pointer to a slice of struct pointers that was bound by the
+// query, typ and mapping must be the same that were given to ApplyPage.
+func PageCursors(q *Query, rows interface{}, typ reflect.Type, mapping map[string]uint64) (Cursors, error) {
+	var cursors Cursors
+
+	if q.page == nil || !q.page.applied {
+		return cursors, errors.New("page was not applied to the query")
+	}
+
+	slice := reflect.Indirect(reflect.ValueOf(rows))
+	if slice.Kind() != reflect.Slice {
+		return cursors, errors.Errorf("rows should be a pointer to a slice but was %T", rows)
+	}
+
+	hasMore := slice.Len() > q.page.limit
+	if hasMore {
+		slice.Set(slice.Slice(0, q.page.limit))
+	}
+	if q.page.before {
+		for i, j := 0, slice.Len()-1; i < j; i, j = i+1, j-1 {
+			tmp := slice.Index(i).Interface()
+			slice.Index(i).Set(slice.Index(j))
+			slice.Index(j).Set(reflect.ValueOf(tmp))
+		}
+	}
+
+	ln := slice.Len()
+	if ln == 0 {
+		return cursors, nil
+	}
+
+	first, last := slice.Index(0), slice.Index(ln-1)
+	hasNext, hasPrev := hasMore, len(q.page.cursor) != 0
+	if q.page.before {
+		hasNext, hasPrev = len(q.page.cursor) != 0, hasMore
+	}
+
+	var err error
+	if hasNext {
+		if cursors.Next, err = encodeCursor(last, typ, mapping, q.page.columns); err != nil {
+			return cursors, err
+		}
+	}
+	if hasPrev {
+		if cursors.Prev, err = encodeCursor(first, typ, mapping, q.page.columns); err != nil {
+			return cursors, err
+		}
+	}
+
+	return cursors, nil
+}
+
+// keysetWhere builds the comparison that selects all rows on one side of the
+// cursor. Where the dialect supports row values this is a single tuple
+// comparison: (a, b) > (?, ?), otherwise it's expanded into:
+// ((a > ?) OR (a = ? AND b > ?))
+func keysetWhere(q *Query, cols []string, op string, vals []interface{}) (string, []interface{}) {
+	if len(cols) == 1 {
+		return fmt.Sprintf("%s %s ?", cols[0], op), vals
+	}
+
+	if q.dialect.UseRowValues {
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(cols)), ",")
+		return fmt.Sprintf("(%s) %s (%s)", strings.Join(cols, ","), op, placeholders), vals
+	}
+
+	var args []interface{}
+	ors := make([]string, len(cols))
+	for i := range cols {
+		ands := make([]string, i+1)
+		for j := 0; j < i; j++ {
+			ands[j] = fmt.Sprintf("%s = ?", cols[j])
+			args = append(args, vals[j])
+		}
+		ands[i] = fmt.Sprintf("%s %s ?", cols[i], op)
+		args = append(args, vals[i])
+
+		ors[i] = "(" + strings.Join(ands, " AND ") + ")"
+	}
+
+	return "(" + strings.Join(ors, " OR ") + ")", args
+}
+
+// qualifyPageColumn prefixes an unqualified column with the table being
+// selected from when the query has joins to avoid ambiguous column names.
+func (q *Query) qualifyPageColumn(col string) string {
+	if len(q.joins) == 0 || len(q.from) == 0 || strings.ContainsRune(col, '.') {
+		return col
+	}
+
+	alias, name, ok := parseFromClause(strings.Split(q.from[0], " "))
+	if !ok {
+		return col
+	}
+	if len(alias) != 0 {
+		name = alias
+	}
+
+	return name + "." + col
+}
+
+// pageColumnName strips the table and quotes from a column so that it can be
+// looked up in a struct mapping.
+func pageColumnName(col string) string {
+	if i := strings.LastIndexByte(col, '.'); i >= 0 {
+		col = col[i+1:]
+	}
+
+	return strings.Trim(col, "\"`[]")
+}
+
+func pageMapping(typ reflect.Type, mapping map[string]uint64, cols []string) ([]string, []uint64, error) {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = pageColumnName(c)
+		if _, ok := mapping[names[i]]; !ok {
+			return nil, nil, errors.Errorf("page column %q does not exist on %s", c, typ.String())
+		}
+	}
+
+	ptrs, err := BindMapping(typ, mapping, names)
+	return names, ptrs, err
+}
+
+// encodeCursor creates an opaque cursor from the page columns of a row
+func encodeCursor(row reflect.Value, typ reflect.Type, mapping map[string]uint64, cols []string) (string, error) {
+	names, ptrs, err := pageMapping(typ, mapping, cols)
+	if err != nil {
+		return "", err
+	}
+
+	vals := ValuesFromMapping(reflect.Indirect(row), ptrs)
+	payload := make(map[string]json.RawMessage, len(names))
+	for i, name := range names {
+		b, err := json.Marshal(vals[i])
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to encode cursor value for %s", name)
+		}
+		payload[name] = b
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode cursor")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeCursor returns the values of the page columns stored in a cursor,
+// converted back into the types of the struct fields they came from.
+func decodeCursor(cursor string, typ reflect.Type, mapping map[string]uint64, cols []string) ([]interface{}, error) {
+	names, ptrs, err := pageMapping(typ, mapping, cols)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed cursor")
+	}
+
+	var payload map[string]json.RawMessage
+	if err = json.Unmarshal(b, &payload); err != nil {
+		return nil, errors.Wrap(err, "malformed cursor")
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	row := reflect.Indirect(reflect.New(typ))
+	fields := PtrsFromMapping(row, ptrs)
+	for i, name := range names {
+		raw, ok := payload[name]
+		if !ok {
+			return nil, errors.Errorf("cursor has no value for column %s", name)
+		}
+		if err = json.Unmarshal(raw, fields[i]); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode cursor value for %s", name)
+		}
+	}
+
+	return ValuesFromMapping(row, ptrs), nil
+}
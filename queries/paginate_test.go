@@ -0,0 +1,219 @@
+package queries
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+type pageTestRow struct {
+	ID        int         `boil:"id"`
+	CreatedAt time.Time   `boil:"created_at"`
+	Name      null.String `boil:"name"`
+}
+
+var (
+	pageTestType    = reflect.TypeOf(&pageTestRow{})
+	pageTestMapping = MakeStructMapping(pageTestType)
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2020, 5, 6, 7, 8, 9, 10, time.UTC)
+	row := &pageTestRow{ID: 5, CreatedAt: now, Name: null.StringFrom("hello")}
+	cols := []string{"created_at", "users.id", "name"}
+
+	cursor, err := encodeCursor(reflect.ValueOf(row), pageTestType, pageTestMapping, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vals, err := decodeCursor(cursor, pageTestType, pageTestMapping, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, ok := vals[0].(time.Time); !ok || !got.Equal(now) {
+		t.Errorf("created_at was wrong: %#v", vals[0])
+	}
+	if got, ok := vals[1].(int); !ok || got != 5 {
+		t.Errorf("id was wrong: %#v", vals[1])
+	}
+	if got, ok := vals[2].(null.String); !ok || got.String != "hello" || !got.Valid {
+		t.Errorf("name was wrong: %#v", vals[2])
+	}
+
+	if _, err = decodeCursor("not a cursor", pageTestType, pageTestMapping, cols); err == nil {
+		t.Error("expected an error for a malformed cursor")
+	}
+	if _, err = decodeCursor(cursor, pageTestType, pageTestMapping, []string{"missing"}); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+}
+
+func TestApplyPage(t *testing.T) {
+	t.Parallel()
+
+	cursor, err := encodeCursor(reflect.ValueOf(&pageTestRow{ID: 3}), pageTestType, pageTestMapping, []string{"created_at", "id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		dialect drivers.Dialect
+		before  bool
+		sql     string
+		nargs   int
+	}{
+		{
+			dialect: drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseRowValues: true},
+			sql:     `SELECT * FROM "t" WHERE (("created_at","id") > ($1,$2)) ORDER BY "created_at" ASC, "id" ASC LIMIT 11;`,
+			nargs:   2,
+		},
+		{
+			dialect: drivers.Dialect{LQ: '`', RQ: '`', UseRowValues: true},
+			before:  true,
+			sql:     "SELECT * FROM `t` WHERE ((`created_at`,`id`) < (?,?)) ORDER BY `created_at` DESC, `id` DESC LIMIT 11;",
+			nargs:   2,
+		},
+		{
+			dialect: drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true},
+			sql:     `SELECT  TOP (11) * FROM [t] WHERE ((([created_at] > $1) OR ([created_at] = $2 AND [id] > $3))) ORDER BY [created_at] ASC, [id] ASC;`,
+			nargs:   3,
+		},
+	}
+
+	for i, test := range tests {
+		dialect := test.dialect
+		q := &Query{dialect: &dialect, from: []string{"t"}}
+		SetPage(q, cursor, 10, test.before)
+		SetPageColumns(q, "created_at", "id")
+
+		if err := ApplyPage(q, pageTestType, pageTestMapping, []string{"id"}, []string{"name"}); err != nil {
+			t.Fatalf("%d) %v", i, err)
+		}
+
+		sql, args := BuildQuery(q)
+		if sql != test.sql {
+			t.Errorf("%d) sql was wrong:\nwant: %s\ngot:  %s", i, test.sql, sql)
+		}
+		if len(args) != test.nargs {
+			t.Errorf("%d) want %d args, got: %d", i, test.nargs, len(args))
+		}
+	}
+}
+
+func TestApplyPageDefaults(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"'}, from: []string{"t"}}
+	if err := ApplyPage(q, pageTestType, pageTestMapping, []string{"id"}, []string{"name"}); err == nil {
+		t.Error("expected an error when no page was set")
+	}
+
+	SetPage(q, "", 2, false)
+	if err := ApplyPage(q, pageTestType, pageTestMapping, []string{"id"}, []string{"name"}); err != nil {
+		t.Fatal(err)
+	}
+
+	sql, args := BuildQuery(q)
+	if want := `SELECT * FROM "t" ORDER BY "id" ASC LIMIT 3;`; sql != want {
+		t.Errorf("sql was wrong:\nwant: %s\ngot:  %s", want, sql)
+	}
+	if len(args) != 0 {
+		t.Errorf("want no args, got: %v", args)
+	}
+}
+
+func TestApplyPageInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		build func(q *Query)
+		err   string
+	}{
+		{
+			build: func(q *Query) { AppendOrderBy(q, "created_at desc") },
+			err:   "a paginated query can't have an order by, use qm.PageBy to order the pages",
+		},
+		{
+			build: func(q *Query) { SetLimit(q, 5) },
+			err:   "a paginated query can't have a limit, the page size limits the rows",
+		},
+		{
+			build: func(q *Query) { SetPageColumns(q, "t.name", "id") },
+			err:   `page column "t.name" is nullable, rows with a NULL in it can't be paginated`,
+		},
+	}
+
+	for i, test := range tests {
+		q := &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"'}, from: []string{"t"}}
+		SetPage(q, "", 2, false)
+		test.build(q)
+
+		err := ApplyPage(q, pageTestType, pageTestMapping, []string{"id"}, []string{"name"})
+		if err == nil || err.Error() != test.err {
+			t.Errorf("%d) want error %q, got: %v", i, test.err, err)
+		}
+	}
+}
+
+func TestPageCursors(t *testing.T) {
+	t.Parallel()
+
+	rows := func(ids ...int) []*pageTestRow {
+		r := make([]*pageTestRow, len(ids))
+		for i, id := range ids {
+			r[i] = &pageTestRow{ID: id}
+		}
+		return r
+	}
+	ids := func(r []*pageTestRow) []int {
+		out := make([]int, len(r))
+		for i, row := range r {
+			out[i] = row.ID
+		}
+		return out
+	}
+
+	tests := []struct {
+		cursor  string
+		before  bool
+		rows    []*pageTestRow
+		ids     []int
+		hasNext bool
+		hasPrev bool
+	}{
+		{rows: rows(1, 2, 3), ids: []int{1, 2}, hasNext: true},
+		{rows: rows(1, 2), ids: []int{1, 2}},
+		{cursor: "x", rows: rows(3, 4, 5), ids: []int{3, 4}, hasNext: true, hasPrev: true},
+		{cursor: "x", rows: rows(3), ids: []int{3}, hasPrev: true},
+		{cursor: "x", before: true, rows: rows(5, 4, 3), ids: []int{4, 5}, hasNext: true, hasPrev: true},
+		{before: true, rows: rows(5, 4), ids: []int{4, 5}},
+		{cursor: "x", rows: nil, ids: []int{}},
+	}
+
+	for i, test := range tests {
+		q := &Query{page: &keyset{columns: []string{"id"}, cursor: test.cursor, limit: 2, before: test.before, applied: true}}
+
+		r := test.rows
+		cursors, err := PageCursors(q, &r, pageTestType, pageTestMapping)
+		if err != nil {
+			t.Fatalf("%d) %v", i, err)
+		}
+
+		if got := ids(r); !reflect.DeepEqual(got, test.ids) {
+			t.Errorf("%d) want ids %v, got: %v", i, test.ids, got)
+		}
+		if (cursors.Next != "") != test.hasNext {
+			t.Errorf("%d) want next cursor: %t, got: %q", i, test.hasNext, cursors.Next)
+		}
+		if (cursors.Prev != "") != test.hasPrev {
+			t.Errorf("%d) want prev cursor: %t, got: %q", i, test.hasPrev, cursors.Prev)
+		}
+	}
+}
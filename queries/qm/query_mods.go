@@ -1,7 +1,9 @@
 package qm
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
@@ -105,6 +107,88 @@ func Load(relationship string, mods ...QueryMod) QueryMod {
 	}
 }
 
+type loadCountQueryMod struct {
+	relationship string
+	mods         []QueryMod
+}
+
+// Apply implements QueryMod.Apply.
+func (qm loadCountQueryMod) Apply(q *queries.Query) {
+	queries.AppendLoadCount(q, qm.relationship)
+
+	if len(qm.mods) != 0 {
+		queries.SetLoadCountMods(q, qm.relationship, queryMods(qm.mods))
+	}
+}
+
+// LoadCount counts the rows of a to-many relationship instead of loading
+// them, with one query grouped by the foreign key. The count is read with
+// the relationship's Count method of the R struct, eg: R.CountVideos().
+//
+//   models.Users(qm.LoadCount("Videos"))
+//
+// The query mods filter the rows that are counted. Relationships before the
+// last one are loaded like Load would:
+//
+//   models.Users(qm.LoadCount("Videos.Tags", Where("deleted = ?", isDeleted)))
+func LoadCount(relationship string, mods ...QueryMod) QueryMod {
+	return loadCountQueryMod{
+		relationship: relationship,
+		mods:         mods,
+	}
+}
+
+type loadJoinedQueryMod struct {
+	relationship string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm loadJoinedQueryMod) Apply(q *queries.Query) {
+	queries.AppendLoadJoined(q, qm.relationship)
+}
+
+// LoadJoined eager loads to-one relationships by LEFT JOINing their tables
+// into the query, instead of running a query for every relationship like
+// Load does. Nested relationships are joined as well:
+//
+//   models.Comments(qm.LoadJoined("Post.Author"))
+//
+// The columns of the joined tables are selected with the relationship as a
+// prefix, eg: "Post.Author.name". Columns in other query mods should be
+// qualified with their table since they could be ambiguous otherwise.
+func LoadJoined(relationship string) QueryMod {
+	return loadJoinedQueryMod{
+		relationship: relationship,
+	}
+}
+
+type loadParallelQueryMod struct {
+	workers int
+}
+
+// Apply implements QueryMod.Apply.
+func (qm loadParallelQueryMod) Apply(q *queries.Query) {
+	queries.SetLoadWorkers(q, qm.workers)
+}
+
+// LoadParallel eager loads the relationships at the same depth concurrently,
+// with up to workers queries at a time:
+//
+//   models.Users(qm.Load("Pets.Toys"), qm.Load("Pets.Vets"), qm.Load("Property"), qm.LoadParallel(4))
+//
+// loads Pets and Property at the same time, and then Toys and Vets. It only
+// applies when the query is run against an executor that can run queries
+// concurrently, like a *sql.DB or one wrapping it (see boil.IsConcurrent), the
+// relationships of a query run in a transaction are loaded one after another.
+// When relationships loaded
+// at the same time fail, the error lists them in the order they were given to
+// Load.
+func LoadParallel(workers int) QueryMod {
+	return loadParallelQueryMod{
+		workers: workers,
+	}
+}
+
 type innerJoinQueryMod struct {
 	clause string
 	args   []interface{}
@@ -211,6 +295,77 @@ func With(clause string, args ...interface{}) QueryMod {
 	}
 }
 
+type unionQueryMod struct {
+	query *queries.Query
+}
+
+// Apply implements QueryMod.Apply.
+func (qm unionQueryMod) Apply(q *queries.Query) {
+	queries.AppendUnion(q, qm.query)
+}
+
+// Union combines the rows of the query with those of another query,
+// removing duplicates. The query's order by, limit and offset apply
+// to the combined rows.
+func Union(query *queries.Query) QueryMod {
+	return unionQueryMod{
+		query: query,
+	}
+}
+
+type unionAllQueryMod struct {
+	query *queries.Query
+}
+
+// Apply implements QueryMod.Apply.
+func (qm unionAllQueryMod) Apply(q *queries.Query) {
+	queries.AppendUnionAll(q, qm.query)
+}
+
+// UnionAll combines the rows of the query with those of another query,
+// keeping duplicates. The query's order by, limit and offset apply
+// to the combined rows.
+func UnionAll(query *queries.Query) QueryMod {
+	return unionAllQueryMod{
+		query: query,
+	}
+}
+
+type intersectQueryMod struct {
+	query *queries.Query
+}
+
+// Apply implements QueryMod.Apply.
+func (qm intersectQueryMod) Apply(q *queries.Query) {
+	queries.AppendIntersect(q, qm.query)
+}
+
+// Intersect keeps only the rows of the query that are also returned by
+// another query. The query's order by, limit and offset apply to the
+// remaining rows.
+func Intersect(query *queries.Query) QueryMod {
+	return intersectQueryMod{
+		query: query,
+	}
+}
+
+type exceptQueryMod struct {
+	query *queries.Query
+}
+
+// Apply implements QueryMod.Apply.
+func (qm exceptQueryMod) Apply(q *queries.Query) {
+	queries.AppendExcept(q, qm.query)
+}
+
+// Except removes the rows returned by another query from the rows of the
+// query. The query's order by, limit and offset apply to the remaining rows.
+func Except(query *queries.Query) QueryMod {
+	return exceptQueryMod{
+		query: query,
+	}
+}
+
 type selectQueryMod struct {
 	columns []string
 }
@@ -416,6 +571,44 @@ func OrNotIn(clause string, args ...interface{}) QueryMod {
 	}
 }
 
+type whereSubqueryQueryMod struct {
+	clause string
+	query  *queries.Query
+}
+
+// Apply implements QueryMod.Apply.
+func (qm whereSubqueryQueryMod) Apply(q *queries.Query) {
+	queries.AppendWhereSubquery(q, qm.clause, qm.query)
+}
+
+// WhereInQuery allows you to specify a "x IN (subquery)" clause for your
+// where statement. The subquery should select a single column, its args are
+// merged into the query's args. Example column: "id", "(column1,column2)"
+func WhereInQuery(column string, subquery *queries.Query) QueryMod {
+	return whereSubqueryQueryMod{
+		clause: column + " IN",
+		query:  subquery,
+	}
+}
+
+// WhereExists allows you to specify an "EXISTS (subquery)" clause for your
+// where statement. The subquery's args are merged into the query's args.
+func WhereExists(subquery *queries.Query) QueryMod {
+	return whereSubqueryQueryMod{
+		clause: "EXISTS",
+		query:  subquery,
+	}
+}
+
+// WhereNotExists allows you to specify a "NOT EXISTS (subquery)" clause for
+// your where statement. The subquery's args are merged into the query's args.
+func WhereNotExists(subquery *queries.Query) QueryMod {
+	return whereSubqueryQueryMod{
+		clause: "NOT EXISTS",
+		query:  subquery,
+	}
+}
+
 // Expr groups where query mods. It's detrimental to use this with any other
 // type of Query Mod because the effects will always only affect where clauses.
 //
@@ -501,11 +694,46 @@ func (qm fromQueryMod) Apply(q *queries.Query) {
 	queries.AppendFrom(q, qm.from)
 }
 
-// From allows to specify the table for your statement
-func From(from string) QueryMod {
-	return fromQueryMod{
-		from: from,
+// From allows to specify the table for your statement. from is either a
+// table or a *queries.Query to select from as a subquery, which must be
+// given an alias. The args of a subquery are merged into the query's args.
+//
+//	From("pilots as p")
+//	From(models.Jets(Where("age > ?", 5)).Query, "old_jets")
+func From(from interface{}, alias ...string) QueryMod {
+	if len(alias) > 1 {
+		panic("qm.From takes a single alias")
 	}
+
+	switch from := from.(type) {
+	case string:
+		if len(alias) != 0 {
+			from += " as " + alias[0]
+		}
+		return fromQueryMod{
+			from: from,
+		}
+	case *queries.Query:
+		if len(alias) == 0 {
+			panic("qm.From needs an alias for a subquery")
+		}
+		return fromSubqueryQueryMod{
+			query: from,
+			alias: alias[0],
+		}
+	default:
+		panic(fmt.Sprintf("qm.From can't select from a %T", from))
+	}
+}
+
+type fromSubqueryQueryMod struct {
+	query *queries.Query
+	alias string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm fromSubqueryQueryMod) Apply(q *queries.Query) {
+	queries.AppendFromSubquery(q, qm.query, qm.alias)
 }
 
 type limitQueryMod struct {
@@ -524,6 +752,76 @@ func Limit(limit int) QueryMod {
 	}
 }
 
+type limitPerParentQueryMod struct {
+	limit int
+}
+
+// Apply implements QueryMod.Apply.
+func (qm limitPerParentQueryMod) Apply(q *queries.Query) {
+	queries.SetLimitPerParent(q, qm.limit)
+}
+
+// LimitPerParent limits the rows of an eager loaded to-many relationship
+// for each of the objects it's loaded for, instead of the rows of all of
+// them. The rows are numbered in the order of the OrderBy mods:
+//
+//   models.Posts(qm.Load("Comments", qm.OrderBy("created_at desc"), qm.LimitPerParent(3)))
+//
+// It needs a database with window functions, eg: Postgres, MySQL 8,
+// MS SQL or SQLite 3.25.
+func LimitPerParent(limit int) QueryMod {
+	return limitPerParentQueryMod{
+		limit: limit,
+	}
+}
+
+type batchSizeQueryMod struct {
+	size int
+}
+
+// Apply implements QueryMod.Apply.
+func (qm batchSizeQueryMod) Apply(q *queries.Query) {
+	queries.SetBatchSize(q, qm.size)
+}
+
+// BatchSize sets how many rows Each and Iter bind at a time before eager
+// loading their relationships. Larger batches need fewer queries to load
+// relationships but hold more rows in memory.
+func BatchSize(size int) QueryMod {
+	return batchSizeQueryMod{
+		size: size,
+	}
+}
+
+type cacheQueryMod struct {
+	ttl  time.Duration
+	tags []string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm cacheQueryMod) Apply(q *queries.Query) {
+	queries.SetCache(q, qm.ttl, qm.tags...)
+}
+
+// Cache looks the results of the query up in the cache set with
+// boil.SetCache, and stores them in it for ttl when they're not there:
+//
+//   models.Countries(qm.Where("active = ?", true), qm.Cache(time.Hour)).All(ctx, db)
+//
+// The results are invalidated when the model's table is written to through
+// the generated models. The results of a query that joins other tables are
+// invalidated by their writes when they're given as tags:
+//
+//   models.Plans(qm.InnerJoin("features on ..."), qm.Cache(time.Hour, "features"))
+//
+// Queries run in a transaction don't use the cache.
+func Cache(ttl time.Duration, tags ...string) QueryMod {
+	return cacheQueryMod{
+		ttl:  ttl,
+		tags: tags,
+	}
+}
+
 type offsetQueryMod struct {
 	offset int
 }
@@ -540,6 +838,58 @@ func Offset(offset int) QueryMod {
 	}
 }
 
+type pageQueryMod struct {
+	cursor string
+	limit  int
+	before bool
+}
+
+// Apply implements QueryMod.Apply.
+func (qm pageQueryMod) Apply(q *queries.Query) {
+	queries.SetPage(q, qm.cursor, qm.limit, qm.before)
+}
+
+// PageAfter selects a page of at most n rows for keyset pagination, starting
+// after the row the cursor points to. An empty cursor selects the first page.
+// Use with the generated Paginate finisher, the cursor is one previously
+// returned as queries.Cursors.Next.
+func PageAfter(cursor string, n int) QueryMod {
+	return pageQueryMod{
+		cursor: cursor,
+		limit:  n,
+	}
+}
+
+// PageBefore selects a page of at most n rows for keyset pagination, ending
+// before the row the cursor points to. An empty cursor selects the last page.
+// Use with the generated Paginate finisher, the cursor is one previously
+// returned as queries.Cursors.Prev.
+func PageBefore(cursor string, n int) QueryMod {
+	return pageQueryMod{
+		cursor: cursor,
+		limit:  n,
+		before: true,
+	}
+}
+
+type pageByQueryMod struct {
+	columns []string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm pageByQueryMod) Apply(q *queries.Query) {
+	queries.SetPageColumns(q, qm.columns...)
+}
+
+// PageBy sets the columns that keyset pagination orders by, by default the
+// primary key is used. Together the columns must uniquely identify a row or
+// rows may be skipped between pages.
+func PageBy(columns ...string) QueryMod {
+	return pageByQueryMod{
+		columns: columns,
+	}
+}
+
 type forQueryMod struct {
 	clause string
 }
@@ -589,3 +939,42 @@ type removeDeletedQueryMod struct{}
 func (removeDeletedQueryMod) Apply(q *queries.Query) {
 	queries.RemoveSoftDeleteWhere(q)
 }
+
+// OnlyDeleted selects only the soft deleted rows of a query, the opposite
+// of the where clause sqlboiler soft-delete places in it.
+func OnlyDeleted() QueryMod {
+	return onlyDeletedQueryMod{}
+}
+
+type onlyDeletedQueryMod struct{}
+
+func (onlyDeletedQueryMod) Apply(q *queries.Query) {
+	queries.SetOnlyDeleted(q)
+}
+
+// AllTenants removes the tenant scope from a query of a table with a tenant
+// column, so it's run for every tenant. The relationships it loads are not
+// scoped either.
+func AllTenants() QueryMod {
+	return allTenantsQueryMod{}
+}
+
+type allTenantsQueryMod struct{}
+
+func (allTenantsQueryMod) Apply(q *queries.Query) {
+	queries.SetAllTenants(q)
+}
+
+// TrackChanges makes the objects the query binds remember the values they
+// were loaded with, so Changes, IsDirty and updates with boil.Dirty can tell
+// which columns were changed since. Relationships eager loaded with Load are
+// not tracked, the ones joined with LoadJoined are.
+func TrackChanges() QueryMod {
+	return trackChangesQueryMod{}
+}
+
+type trackChangesQueryMod struct{}
+
+func (trackChangesQueryMod) Apply(q *queries.Query) {
+	queries.SetTrackChanges(q)
+}
@@ -10,12 +10,12 @@ import (
 	"github.com/aarondl/sqlboiler/v4/drivers"
 )
 
-// joinKind is the type of join
-type joinKind int
+// JoinKind is the type of join
+type JoinKind int
 
 // Join type constants
 const (
-	JoinInner joinKind = iota
+	JoinInner JoinKind = iota
 	JoinOuterLeft
 	JoinOuterRight
 	JoinNatural
@@ -27,28 +27,43 @@ type Query struct {
 	dialect *drivers.Dialect
 	rawSQL  rawSQL
 
-	load     []string
-	loadMods map[string]Applicator
-
-	delete     bool
-	update     map[string]interface{}
-	withs      []argClause
-	selectCols []string
-	count      bool
-	from       []string
-	joins      []join
-	where      []where
-	groupBy    []string
-	orderBy    []argClause
-	having     []argClause
-	limit      *int
-	offset     int
-	forlock    string
-	distinct   string
-	comment    string
+	load        []string
+	loadMods    map[string]Applicator
+	loadJoined  []string
+	loadCounts  []string
+	countMods   map[string]Applicator
+	loadWorkers int
+	batchSize   int
+	track       bool
+	cache       queryCache
+
+	delete      bool
+	update      map[string]interface{}
+	withs       []argClause
+	selectCols  []string
+	count       bool
+	from        []string
+	fromQueries []fromQuery
+	joins       []join
+	where       []where
+	groupBy     []string
+	orderBy     []argClause
+	having      []argClause
+	setOps      []setOp
+	limit       *int
+	offset      int
+	perParent   perParentLimit
+	forlock     string
+	distinct    string
+	comment     string
+	page        *keyset
+	rewritten   bool
+	tenant      tenantScope
+	softDelete  softDeleteScope
 
 	// This field is a hack to allow a query to strip out the reference
-	// to deleted at is null.
+	// to deleted at is null. It's only used by queries of models generated
+	// before the soft delete column was set with SetSoftDeleteColumn.
 	removeSoftDelete bool
 }
 
@@ -67,6 +82,7 @@ const (
 	whereKindRightParen
 	whereKindIn
 	whereKindNotIn
+	whereKindSubquery
 )
 
 type where struct {
@@ -75,6 +91,9 @@ type where struct {
 	clause      string
 	orSeparator bool
 	args        []interface{}
+
+	// query is the subquery of a whereKindSubquery
+	query *Query
 }
 
 type in struct {
@@ -94,11 +113,32 @@ type rawSQL struct {
 }
 
 type join struct {
-	kind   joinKind
+	kind   JoinKind
 	clause string
 	args   []interface{}
 }
 
+// fromQuery is a subquery selected from with an alias
+type fromQuery struct {
+	query *Query
+	alias string
+}
+
+// setOpKind is the type of set operation
+type setOpKind int
+
+const (
+	setOpUnion setOpKind = iota
+	setOpUnionAll
+	setOpIntersect
+	setOpExcept
+)
+
+type setOp struct {
+	kind  setOpKind
+	query *Query
+}
+
 // Raw makes a raw query, usually for use with bind
 func Raw(query string, args ...interface{}) *Query {
 	return &Query{
@@ -116,75 +156,99 @@ func RawG(query string, args ...interface{}) *Query {
 
 // Exec executes a query that does not need a row returned
 func (q *Query) Exec(exec boil.Executor) (sql.Result, error) {
-	qs, args := BuildQuery(q)
+	scoped, err := q.scopeTenant(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	qs, args := BuildQuery(scoped)
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
-		fmt.Fprintln(boil.DebugWriter, args)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(qs, args))
 	}
 	return exec.Exec(qs, args...)
 }
 
 // QueryRowG executes the query for the One finisher and returns a row.
 // It uses the global executer.
-func (q *Query) QueryRowG() *sql.Row {
+func (q *Query) QueryRowG() boil.Row {
 	return q.QueryRow(boil.GetDB())
 }
 
 // QueryRow executes the query for the One finisher and returns a row
-func (q *Query) QueryRow(exec boil.Executor) *sql.Row {
-	qs, args := BuildQuery(q)
+func (q *Query) QueryRow(exec boil.Executor) boil.Row {
+	scoped, err := q.scopeTenant(context.Background())
+	if err != nil {
+		return boil.ErrorRow(err)
+	}
+	qs, args := BuildQuery(scoped)
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
-		fmt.Fprintln(boil.DebugWriter, args)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(qs, args))
 	}
-	return exec.QueryRow(qs, args...)
+	return boil.QueryRow(exec, qs, args...)
 }
 
 // Query executes the query for the All finisher and returns multiple rows
 func (q *Query) Query(exec boil.Executor) (*sql.Rows, error) {
-	qs, args := BuildQuery(q)
+	scoped, err := q.scopeTenant(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	qs, args := BuildQuery(scoped)
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
-		fmt.Fprintln(boil.DebugWriter, args)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(qs, args))
 	}
 	return exec.Query(qs, args...)
 }
 
 // ExecContext executes a query that does not need a row returned
 func (q *Query) ExecContext(ctx context.Context, exec boil.ContextExecutor) (sql.Result, error) {
-	qs, args := BuildQuery(q)
+	scoped, err := q.scopeTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	qs, args := BuildQuery(scoped)
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, qs)
-		fmt.Fprintln(writer, args)
+		fmt.Fprintln(writer, boil.RedactArgs(qs, args))
 	}
 	return exec.ExecContext(ctx, qs, args...)
 }
 
 // QueryRowContextG executes the query for the One finisher and returns a row.
 // It uses the global executer.
-func (q *Query) QueryRowContextG(ctx context.Context) *sql.Row {
+func (q *Query) QueryRowContextG(ctx context.Context) boil.Row {
 	return q.QueryRowContext(ctx, boil.GetContextDB())
 }
 
 // QueryRowContext executes the query for the One finisher and returns a row
-func (q *Query) QueryRowContext(ctx context.Context, exec boil.ContextExecutor) *sql.Row {
-	qs, args := BuildQuery(q)
+func (q *Query) QueryRowContext(ctx context.Context, exec boil.ContextExecutor) boil.Row {
+	scoped, err := q.scopeTenant(ctx)
+	if err != nil {
+		return boil.ErrorRow(err)
+	}
+	qs, args := BuildQuery(scoped)
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, qs)
-		fmt.Fprintln(writer, args)
+		fmt.Fprintln(writer, boil.RedactArgs(qs, args))
 	}
-	return exec.QueryRowContext(ctx, qs, args...)
+	return boil.QueryRowContext(ctx, exec, qs, args...)
 }
 
 // QueryContext executes the query for the All finisher and returns multiple rows
 func (q *Query) QueryContext(ctx context.Context, exec boil.ContextExecutor) (*sql.Rows, error) {
-	qs, args := BuildQuery(q)
+	scoped, err := q.scopeTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	qs, args := BuildQuery(scoped)
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, qs)
-		fmt.Fprintln(writer, args)
+		fmt.Fprintln(writer, boil.RedactArgs(qs, args))
 	}
 	return exec.QueryContext(ctx, qs, args...)
 }
@@ -309,6 +373,28 @@ func AppendLoad(q *Query, relationships string) {
 	q.load = append(q.load, relationships)
 }
 
+// AppendLoadJoined on the query, the relationships are joined into the query
+// instead of being eager loaded with queries of their own.
+func AppendLoadJoined(q *Query, relationships string) {
+	q.loadJoined = append(q.loadJoined, relationships)
+}
+
+// AppendLoadCount on the query, the relationships are counted instead of
+// being loaded.
+func AppendLoadCount(q *Query, relationships string) {
+	q.loadCounts = append(q.loadCounts, relationships)
+}
+
+// SetLoadCountMods on the query, they filter the rows of the relationship
+// that are counted.
+func SetLoadCountMods(q *Query, rel string, appl Applicator) {
+	if q.countMods == nil {
+		q.countMods = make(map[string]Applicator)
+	}
+
+	q.countMods[rel] = appl
+}
+
 // SetLoadMods on the query.
 func SetLoadMods(q *Query, rel string, appl Applicator) {
 	if q.loadMods == nil {
@@ -318,6 +404,18 @@ func SetLoadMods(q *Query, rel string, appl Applicator) {
 	q.loadMods[rel] = appl
 }
 
+// SetLoadWorkers sets how many relationships at the same depth are eager
+// loaded at a time.
+func SetLoadWorkers(q *Query, workers int) {
+	q.loadWorkers = workers
+}
+
+// SetBatchSize sets how many rows BindEach binds before the relationships
+// are eager loaded for them.
+func SetBatchSize(q *Query, size int) {
+	q.batchSize = size
+}
+
 // SetSelect on the query.
 func SetSelect(q *Query, sel []string) {
 	q.selectCols = sel
@@ -363,6 +461,26 @@ func SetComment(q *Query, comment string) {
 	q.comment = comment
 }
 
+// SetPage on the query.
+func SetPage(q *Query, cursor string, limit int, before bool) {
+	if q.page == nil {
+		q.page = &keyset{}
+	}
+
+	q.page.cursor = cursor
+	q.page.limit = limit
+	q.page.before = before
+}
+
+// SetPageColumns on the query.
+func SetPageColumns(q *Query, columns ...string) {
+	if q.page == nil {
+		q.page = &keyset{}
+	}
+
+	q.page.columns = append([]string(nil), columns...)
+}
+
 // SetUpdate on the query.
 func SetUpdate(q *Query, cols map[string]interface{}) {
 	q.update = cols
@@ -378,6 +496,11 @@ func AppendFrom(q *Query, from ...string) {
 	q.from = append(q.from, from...)
 }
 
+// AppendFromSubquery on the query.
+func AppendFromSubquery(q *Query, sub *Query, alias string) {
+	q.fromQueries = append(q.fromQueries, fromQuery{query: sub, alias: alias})
+}
+
 // SetFrom replaces the current from statements.
 func SetFrom(q *Query, from ...string) {
 	q.from = append([]string(nil), from...)
@@ -423,6 +546,12 @@ func AppendNotIn(q *Query, clause string, args ...interface{}) {
 	q.where = append(q.where, where{kind: whereKindNotIn, clause: clause, args: args})
 }
 
+// AppendWhereSubquery on the query. The subquery is put in parentheses
+// after the clause, for example "id IN" or "EXISTS".
+func AppendWhereSubquery(q *Query, clause string, sub *Query) {
+	q.where = append(q.where, where{kind: whereKindSubquery, clause: clause, query: sub})
+}
+
 // SetLastWhereAsOr sets the or separator for the tail "WHERE" in the slice
 func SetLastWhereAsOr(q *Query) {
 	if len(q.where) == 0 {
@@ -479,21 +608,73 @@ func AppendOrderBy(q *Query, clause string, args ...interface{}) {
 	q.orderBy = append(q.orderBy, argClause{clause: clause, args: args})
 }
 
+// AppendUnion on the query.
+func AppendUnion(q *Query, other *Query) {
+	q.setOps = append(q.setOps, setOp{kind: setOpUnion, query: other})
+}
+
+// AppendUnionAll on the query.
+func AppendUnionAll(q *Query, other *Query) {
+	q.setOps = append(q.setOps, setOp{kind: setOpUnionAll, query: other})
+}
+
+// AppendIntersect on the query.
+func AppendIntersect(q *Query, other *Query) {
+	q.setOps = append(q.setOps, setOp{kind: setOpIntersect, query: other})
+}
+
+// AppendExcept on the query.
+func AppendExcept(q *Query, other *Query) {
+	q.setOps = append(q.setOps, setOp{kind: setOpExcept, query: other})
+}
+
 // AppendWith on the query.
 func AppendWith(q *Query, clause string, args ...interface{}) {
 	q.withs = append(q.withs, argClause{clause: clause, args: args})
 }
 
+type softDeleteMode int
+
+const (
+	softDeleteExclude softDeleteMode = iota
+	softDeleteInclude
+	softDeleteOnly
+)
+
+// softDeleteScope filters the soft deleted rows out of a query, or all the
+// rows that aren't soft deleted
+type softDeleteScope struct {
+	column string
+	mode   softDeleteMode
+}
+
+// SetSoftDeleteColumn filters the rows soft deleted with the column out of
+// the query, see RemoveSoftDeleteWhere and SetOnlyDeleted.
+func SetSoftDeleteColumn(q *Query, column string) {
+	q.softDelete.column = column
+}
+
 // RemoveSoftDeleteWhere prevents the automatic soft delete where clause
 // from being included when building the query.
 func RemoveSoftDeleteWhere(q *Query) {
 	q.removeSoftDelete = true
+	q.softDelete.mode = softDeleteInclude
+}
+
+// SetOnlyDeleted selects only the soft deleted rows instead of leaving them
+// out of the query.
+func SetOnlyDeleted(q *Query) {
+	q.softDelete.mode = softDeleteOnly
 }
 
 var removeSoftDeleteRgx = regexp.MustCompile("deleted_at[\"'`]? is null")
 
 // SetRemoveSoftDeleteRgx sets the removeSoftDeleteRgx variable to support
 // qm.Where method to work with a custom soft delete auto-column name.
+//
+// Deprecated: the soft delete column is set on queries with
+// SetSoftDeleteColumn, the regexp is only used for models that were
+// generated before it was.
 func SetRemoveSoftDeleteRgx(rgx *regexp.Regexp) {
 	removeSoftDeleteRgx = rgx
 }
@@ -501,7 +682,7 @@ func SetRemoveSoftDeleteRgx(rgx *regexp.Regexp) {
 // removeSoftDeleteWhere attempts to remove the soft delete where clause
 // added automatically by sqlboiler.
 func (q *Query) removeSoftDeleteWhere() {
-	if !q.removeSoftDelete {
+	if !q.removeSoftDelete || len(q.softDelete.column) != 0 {
 		return
 	}
 
@@ -23,6 +23,7 @@ func BuildQuery(q *Query) (string, []interface{}) {
 	var buf *bytes.Buffer
 	var args []interface{}
 
+	q.runRewriters()
 	q.removeSoftDeleteWhere()
 
 	switch {
@@ -53,17 +54,33 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	writeComment(q, buf)
 	writeCTEs(q, buf, &args)
 
-	hasHaving := len(q.having) != 0
-	hasGroupBy := len(q.groupBy) != 0
-	hasSimpleCount := q.count && !hasHaving && !hasGroupBy
-	hasComplexCount := q.count && (hasHaving || hasGroupBy)
+	hasComplexCount := q.count && (len(q.having) != 0 || len(q.groupBy) != 0 || len(q.setOps) != 0)
 	if hasComplexCount {
 		buf.WriteString("SELECT COUNT(*) FROM (")
 	}
 
+	if q.hasLimitPerParent() {
+		writeLimitPerParent(q, buf, &args)
+	} else {
+		writeSelect(q, buf, &args, q.count && !hasComplexCount)
+		writeModifiers(q, buf, &args)
+	}
+
+	if hasComplexCount {
+		buf.WriteString(") AS q")
+	}
+	buf.WriteByte(';')
+	return buf, args
+}
+
+// writeSelect writes the SELECT, FROM, JOIN and WHERE clauses of a query,
+// numbering placeholders after the args already written.
+func writeSelect(q *Query, buf *bytes.Buffer, args *[]interface{}, hasSimpleCount bool) {
 	buf.WriteString("SELECT ")
 
-	if q.dialect.UseTopClause {
+	// TOP would only limit the first query of a set operation, the
+	// combined result is limited with FETCH NEXT instead
+	if q.dialect.UseTopClause && len(q.setOps) == 0 {
 		if q.limit != nil && q.offset == 0 {
 			fmt.Fprintf(buf, " TOP (%d) ", *q.limit)
 		}
@@ -104,9 +121,17 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	}
 
 	fmt.Fprintf(buf, " FROM %s", strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
+	for i, f := range q.fromQueries {
+		if i > 0 || len(q.from) != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteByte('(')
+		writeSubquery(f.query, buf, args)
+		fmt.Fprintf(buf, ") AS %s", strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, f.alias))
+	}
 
 	if len(q.joins) > 0 {
-		argsLen := len(args)
+		argsLen := len(*args)
 		joinBuf := strmangle.GetBuffer()
 		for _, j := range q.joins {
 			switch j.kind {
@@ -121,7 +146,7 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 			default:
 				panic(fmt.Sprintf("Unsupported join of kind %v", j.kind))
 			}
-			args = append(args, j.args...)
+			*args = append(*args, j.args...)
 		}
 		var resp string
 		if q.dialect.UseIndexPlaceholders {
@@ -133,19 +158,11 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 		strmangle.PutBuffer(joinBuf)
 	}
 
-	where, whereArgs := whereClause(q, len(args)+1)
+	where, whereArgs := whereClause(q, len(*args)+1)
 	buf.WriteString(where)
 	if len(whereArgs) != 0 {
-		args = append(args, whereArgs...)
+		*args = append(*args, whereArgs...)
 	}
-
-	writeModifiers(q, buf, &args)
-
-	if hasComplexCount {
-		buf.WriteString(") AS q")
-	}
-	buf.WriteByte(';')
-	return buf, args
 }
 
 func buildDeleteQuery(q *Query) (*bytes.Buffer, []interface{}) {
@@ -248,6 +265,10 @@ func writeModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 		writeParameterizedModifiers(q, buf, args, " HAVING ", " AND ", q.having)
 	}
 
+	if len(q.setOps) != 0 {
+		writeSetOps(q, buf, args)
+	}
+
 	if len(q.orderBy) != 0 {
 		writeParameterizedModifiers(q, buf, args, " ORDER BY ", ", ", q.orderBy)
 	}
@@ -265,7 +286,7 @@ func writeModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 		// ORDER BY ...
 		// OFFSET N ROWS
 		// FETCH NEXT M ROWS ONLY
-		if q.offset != 0 {
+		if q.offset != 0 || (len(q.setOps) != 0 && q.limit != nil) {
 
 			// Hack from https://www.microsoftpressstore.com/articles/article.aspx?p=2314819
 			// ...
@@ -294,8 +315,52 @@ func writeModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 	}
 }
 
+// writeSetOps writes the queries combined with q by set operations. They're
+// written after q's GROUP BY and HAVING so that q's ORDER BY and LIMIT apply
+// to the combined result. A combined query with its own ORDER BY or LIMIT is
+// put in parentheses, which not every database supports.
+func writeSetOps(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	for _, op := range q.setOps {
+		switch op.kind {
+		case setOpUnion:
+			buf.WriteString(" UNION ")
+		case setOpUnionAll:
+			buf.WriteString(" UNION ALL ")
+		case setOpIntersect:
+			buf.WriteString(" INTERSECT ")
+		case setOpExcept:
+			buf.WriteString(" EXCEPT ")
+		default:
+			panic(fmt.Sprintf("Unsupported set operation of kind %v", op.kind))
+		}
+
+		other := op.query
+		hasOwnModifiers := len(other.orderBy) != 0 || other.limit != nil || other.offset != 0
+		if hasOwnModifiers {
+			buf.WriteByte('(')
+		}
+		writeSubquery(other, buf, args)
+		if hasOwnModifiers {
+			buf.WriteByte(')')
+		}
+	}
+}
+
+// writeSubquery writes a select query embedded in another query, with its
+// comment and CTEs, numbering its placeholders after the args already
+// written.
+func writeSubquery(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	q.runRewriters()
+	q.removeSoftDeleteWhere()
+
+	writeComment(q, buf)
+	writeCTEs(q, buf, args)
+	writeSelect(q, buf, args, false)
+	writeModifiers(q, buf, args)
+}
+
 func writeStars(q *Query) []string {
-	cols := make([]string, len(q.from))
+	cols := make([]string, len(q.from), len(q.from)+len(q.fromQueries))
 	for i, f := range q.from {
 		toks := strings.Split(f, " ")
 		if len(toks) == 1 {
@@ -313,6 +378,9 @@ func writeStars(q *Query) []string {
 		}
 		cols[i] = fmt.Sprintf(`%s.*`, strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, name))
 	}
+	for _, f := range q.fromQueries {
+		cols = append(cols, fmt.Sprintf(`%s.*`, strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, f.alias)))
+	}
 
 	return cols
 }
@@ -348,7 +416,9 @@ func writeAsStatements(q *Query) []string {
 //
 // startAt specifies what number placeholders start at
 func whereClause(q *Query, startAt int) (string, []interface{}) {
-	if len(q.where) == 0 {
+	tenant := q.tenant.column != "" && !q.tenant.all
+	softDelete := q.softDelete.column != "" && q.softDelete.mode != softDeleteInclude
+	if len(q.where) == 0 && !tenant && !softDelete {
 		return "", nil
 	}
 
@@ -368,6 +438,36 @@ ManualParen:
 
 	notFirstExpression := false
 	buf.WriteString(" WHERE ")
+	if tenant {
+		// The tenant and soft delete filters come first so or'd clauses
+		// can't escape them
+		buf.WriteString(q.tenant.column)
+		if q.dialect.UseIndexPlaceholders {
+			fmt.Fprintf(buf, " = $%d", startAt)
+		} else {
+			buf.WriteString(" = ?")
+		}
+		startAt++
+		args = append(args, q.tenant.tenant)
+	}
+	if softDelete {
+		if tenant {
+			buf.WriteString(" AND ")
+		}
+		buf.WriteString(q.softDelete.column)
+		if q.softDelete.mode == softDeleteOnly {
+			buf.WriteString(" IS NOT NULL")
+		} else {
+			buf.WriteString(" IS NULL")
+		}
+	}
+	scoped := tenant || softDelete
+	if scoped {
+		if len(q.where) == 0 {
+			return buf.String(), args
+		}
+		buf.WriteString(" AND (")
+	}
 	for _, where := range q.where {
 		if notFirstExpression && where.kind != whereKindRightParen {
 			if where.orSeparator {
@@ -395,6 +495,22 @@ ManualParen:
 				buf.WriteByte(')')
 			}
 			args = append(args, where.args...)
+		case whereKindSubquery:
+			if !manualParens {
+				buf.WriteByte('(')
+			}
+			// The subquery numbers its placeholders from the length of the
+			// args, so they're padded to the current position
+			subArgs := make([]interface{}, startAt-1)
+			fmt.Fprintf(buf, "%s (", where.clause)
+			writeSubquery(where.query, buf, &subArgs)
+			buf.WriteByte(')')
+			if !manualParens {
+				buf.WriteByte(')')
+			}
+			subArgs = subArgs[startAt-1:]
+			args = append(args, subArgs...)
+			startAt += len(subArgs)
 		case whereKindLeftParen:
 			buf.WriteByte('(')
 			notFirstExpression = false
@@ -485,6 +601,9 @@ ManualParen:
 			panic("unknown where type")
 		}
 	}
+	if scoped {
+		buf.WriteByte(')')
+	}
 
 	return buf.String(), args
 }
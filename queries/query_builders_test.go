@@ -512,6 +512,60 @@ func TestInClause(t *testing.T) {
 	}
 }
 
+func TestSoftDeleteWhereClause(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		q      Query
+		expect string
+		args   []interface{}
+	}{
+		{
+			q:      Query{softDelete: softDeleteScope{column: `"t"."deleted_at"`}},
+			expect: ` WHERE "t"."deleted_at" IS NULL`,
+		},
+		{
+			q: Query{
+				softDelete: softDeleteScope{column: `"t"."deleted_at"`, mode: softDeleteOnly},
+				where:      []where{{clause: "a=?", args: []interface{}{1}}},
+			},
+			expect: ` WHERE "t"."deleted_at" IS NOT NULL AND ((a=$1))`,
+			args:   []interface{}{1},
+		},
+		{
+			q: Query{
+				softDelete: softDeleteScope{column: `"t"."deleted_at"`, mode: softDeleteInclude},
+				where:      []where{{clause: "a=?", args: []interface{}{1}}},
+			},
+			expect: ` WHERE (a=$1)`,
+			args:   []interface{}{1},
+		},
+		{
+			q: Query{
+				tenant:     tenantScope{column: `"t"."tenant_id"`, tenant: 5},
+				softDelete: softDeleteScope{column: `"t"."deleted_at"`},
+				where: []where{
+					{clause: "a=?", args: []interface{}{1}},
+					{clause: "b=?", orSeparator: true, args: []interface{}{2}},
+				},
+			},
+			expect: ` WHERE "t"."tenant_id" = $1 AND "t"."deleted_at" IS NULL AND ((a=$2) OR (b=$3))`,
+			args:   []interface{}{5, 1, 2},
+		},
+	}
+
+	for i, test := range tests {
+		test.q.dialect = &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+		result, args := whereClause(&test.q, 1)
+		if result != test.expect {
+			t.Errorf("%d) Mismatch between expect and result:\n%s\n%s\n", i, test.expect, result)
+		}
+		if !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%d) Mismatch between expected args:\n%#v\n%#v\n", i, test.args, args)
+		}
+	}
+}
+
 func TestLimitClause(t *testing.T) {
 	t.Parallel()
 
@@ -707,3 +761,176 @@ func TestWriteComment(t *testing.T) {
 		t.Errorf(`bad two lines comment, got: %s`, got)
 	}
 }
+
+func TestBuildSetOpsQuery(t *testing.T) {
+	t.Parallel()
+
+	psql := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	mysql := &drivers.Dialect{LQ: '`', RQ: '`'}
+	mssql := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true}
+
+	tests := []struct {
+		dialect *drivers.Dialect
+		build   func(q, other *Query)
+		sql     string
+		args    []interface{}
+	}{
+		{
+			dialect: psql,
+			build: func(q, other *Query) {
+				AppendWhere(q, "a = ?", 1)
+				AppendWhere(other, "b = ? and c = ?", 2, 3)
+				AppendUnion(q, other)
+				AppendOrderBy(q, "id desc")
+				SetLimit(q, 5)
+			},
+			sql:  `SELECT * FROM "posts" WHERE (a = $1) UNION SELECT * FROM "reposts" WHERE (b = $2 and c = $3) ORDER BY id desc LIMIT 5;`,
+			args: []interface{}{1, 2, 3},
+		},
+		{
+			dialect: psql,
+			build: func(q, other *Query) {
+				AppendWhere(q, "a = ?", 1)
+				AppendIn(other, "b in ?", 2, 3)
+				AppendOrderBy(other, "b")
+				SetLimit(other, 1)
+				AppendUnionAll(q, other)
+				AppendOrderBy(q, "a > ?", 4)
+			},
+			sql:  `SELECT * FROM "posts" WHERE (a = $1) UNION ALL (SELECT * FROM "reposts" WHERE ("b" IN ($2,$3)) ORDER BY b LIMIT 1) ORDER BY a > $4;`,
+			args: []interface{}{1, 2, 3, 4},
+		},
+		{
+			dialect: mysql,
+			build: func(q, other *Query) {
+				AppendWhere(q, "a = ?", 1)
+				AppendWhere(other, "b = ?", 2)
+				AppendIntersect(q, other)
+				AppendExcept(q, &Query{dialect: mysql, from: []string{"drafts"}})
+			},
+			sql:  "SELECT * FROM `posts` WHERE (a = ?) INTERSECT SELECT * FROM `reposts` WHERE (b = ?) EXCEPT SELECT * FROM `drafts`;",
+			args: []interface{}{1, 2},
+		},
+		{
+			dialect: psql,
+			build: func(q, other *Query) {
+				AppendUnion(q, other)
+				SetCount(q)
+			},
+			sql: `SELECT COUNT(*) FROM (SELECT * FROM "posts" UNION SELECT * FROM "reposts") AS q;`,
+		},
+		{
+			dialect: mssql,
+			build: func(q, other *Query) {
+				AppendWhere(other, "b = ?", 2)
+				AppendUnion(q, other)
+				AppendOrderBy(q, "id")
+				SetLimit(q, 5)
+			},
+			sql:  `SELECT * FROM [posts] UNION SELECT * FROM [reposts] WHERE (b = $1) ORDER BY id OFFSET 0 ROWS FETCH NEXT 5 ROWS ONLY;`,
+			args: []interface{}{2},
+		},
+	}
+
+	for i, test := range tests {
+		q := &Query{dialect: test.dialect, from: []string{"posts"}}
+		other := &Query{dialect: test.dialect, from: []string{"reposts"}}
+		test.build(q, other)
+
+		sql, args := BuildQuery(q)
+		if sql != test.sql {
+			t.Errorf("%d) wrong sql:\nwant: %s\ngot:  %s", i, test.sql, sql)
+		}
+		if !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%d) wrong args, want: %#v, got: %#v", i, test.args, args)
+		}
+	}
+}
+
+func TestBuildSubqueryQuery(t *testing.T) {
+	t.Parallel()
+
+	psql := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	mysql := &drivers.Dialect{LQ: '`', RQ: '`'}
+
+	tests := []struct {
+		dialect *drivers.Dialect
+		build   func(q, sub *Query)
+		sql     string
+		args    []interface{}
+	}{
+		{
+			dialect: psql,
+			build: func(q, sub *Query) {
+				AppendWhere(q, "a = ?", 1)
+				SetSelect(sub, []string{"user_id"})
+				AppendWhere(sub, "b = ? and c = ?", 2, 3)
+				AppendWhereSubquery(q, "id IN", sub)
+				AppendWhere(q, "d = ?", 4)
+				SetLastWhereAsOr(q)
+			},
+			sql:  `SELECT * FROM "users" WHERE (a = $1) AND (id IN (SELECT "user_id" FROM "posts" WHERE (b = $2 and c = $3))) OR (d = $4);`,
+			args: []interface{}{1, 2, 3, 4},
+		},
+		{
+			dialect: psql,
+			build: func(q, sub *Query) {
+				AppendWhere(sub, "posts.user_id = users.id")
+				AppendIn(sub, "kind in ?", 1, 2)
+				AppendWhereSubquery(q, "NOT EXISTS", sub)
+				AppendWhere(q, "a = ?", 3)
+			},
+			sql:  `SELECT * FROM "users" WHERE (NOT EXISTS (SELECT * FROM "posts" WHERE (posts.user_id = users.id) AND ("kind" IN ($1,$2)))) AND (a = $3);`,
+			args: []interface{}{1, 2, 3},
+		},
+		{
+			dialect: psql,
+			build: func(q, sub *Query) {
+				SetFrom(q)
+				AppendWhere(sub, "b = ?", 1)
+				AppendFromSubquery(q, sub, "p")
+				AppendWhere(q, "p.a = ?", 2)
+			},
+			sql:  `SELECT * FROM (SELECT * FROM "posts" WHERE (b = $1)) AS "p" WHERE (p.a = $2);`,
+			args: []interface{}{1, 2},
+		},
+		{
+			dialect: psql,
+			build: func(q, sub *Query) {
+				AppendWhere(q, "a = ?", 1)
+				SetComment(sub, "recent")
+				AppendWith(sub, "recent AS (SELECT * FROM posts WHERE b > ?)", 2)
+				SetFrom(sub, "recent")
+				SetSelect(sub, []string{"user_id"})
+				AppendWhere(sub, "c = ?", 3)
+				AppendWhereSubquery(q, "id IN", sub)
+			},
+			sql:  "SELECT * FROM \"users\" WHERE (a = $1) AND (id IN (-- recent\nWITH recent AS (SELECT * FROM posts WHERE b > $2) SELECT \"user_id\" FROM \"recent\" WHERE (c = $3)));",
+			args: []interface{}{1, 2, 3},
+		},
+		{
+			dialect: mysql,
+			build: func(q, sub *Query) {
+				AppendWhere(sub, "b = ?", 1)
+				AppendFromSubquery(q, sub, "p")
+				AppendWhereSubquery(q, "EXISTS", &Query{dialect: mysql, from: []string{"tags"}, where: []where{{clause: "c = ?", args: []interface{}{2}}}})
+			},
+			sql:  "SELECT * FROM `users`, (SELECT * FROM `posts` WHERE (b = ?)) AS `p` WHERE (EXISTS (SELECT * FROM `tags` WHERE (c = ?)));",
+			args: []interface{}{1, 2},
+		},
+	}
+
+	for i, test := range tests {
+		q := &Query{dialect: test.dialect, from: []string{"users"}}
+		sub := &Query{dialect: test.dialect, from: []string{"posts"}}
+		test.build(q, sub)
+
+		sql, args := BuildQuery(q)
+		if sql != test.sql {
+			t.Errorf("%d) wrong sql:\nwant: %s\ngot:  %s", i, test.sql, sql)
+		}
+		if !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%d) wrong args, want: %#v, got: %#v", i, test.args, args)
+		}
+	}
+}
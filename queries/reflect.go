@@ -29,6 +29,7 @@ const (
 
 const (
 	loadMethodPrefix       = "Load"
+	countMethodPrefix      = "Count"
 	relationshipStructName = "R"
 	loaderStructName       = "L"
 	sentinel               = uint64(255)
@@ -120,7 +121,7 @@ func Bind(rows *sql.Rows, obj interface{}) error {
 		return err
 	}
 
-	return bind(rows, obj, structType, sliceType, singular)
+	return bind(rows, obj, structType, sliceType, singular, nil, false)
 }
 
 // Bind executes the query and inserts the
@@ -131,6 +132,9 @@ func Bind(rows *sql.Rows, obj interface{}) error {
 // If Context is non-nil, any eager loading that's done must also
 // be using load* methods that support context as the first parameter.
 //
+// When the query has a cache TTL, see SetCache, the rows are bound from the
+// cache when they're in it. Relationships are still eager loaded.
+//
 // Also see documentation for Bind()
 func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) error {
 	structType, sliceType, bkind, err := bindChecks(obj)
@@ -138,6 +142,55 @@ func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) e
 		return err
 	}
 
+	cacheKey, err := q.cacheKey(ctx, exec, structType, bkind)
+	if err != nil {
+		return err
+	}
+	var bound int
+	if bkind != kindStruct {
+		bound = reflect.Indirect(reflect.ValueOf(obj)).Len()
+	}
+	if cacheKey == "" || !bindFromCache(cacheKey, obj, structType, bkind) {
+		if err = q.bindRows(ctx, exec, obj, structType, sliceType, bkind); err != nil {
+			return err
+		}
+		if cacheKey != "" {
+			q.storeInCache(ctx, cacheKey, obj, bkind, bound)
+		}
+	}
+	// The objects are snapshotted after they're cached so the cached copies
+	// don't share their snapshots
+	if q.track && isTracker(structType) {
+		snapshotBound(obj, bkind, bound)
+	}
+
+	if len(q.loadJoined) != 0 {
+		joined, err := resolveJoinedLoads(structType, q.loadJoined)
+		if err != nil {
+			return err
+		}
+		if err = joined.afterBind(ctx, exec, obj, bkind, bound); err != nil {
+			return err
+		}
+	}
+
+	if len(q.load) != 0 || len(q.loadCounts) != 0 {
+		return q.eagerLoad(ctx, exec, obj, bkind)
+	}
+
+	return nil
+}
+
+// bindRows executes the query and binds its rows to obj.
+func (q *Query) bindRows(ctx context.Context, exec boil.Executor, obj interface{}, structType, sliceType reflect.Type, bkind bindKind) error {
+	var joined joinedLoads
+	var err error
+	if len(q.loadJoined) != 0 {
+		if q, joined, err = q.joinLoads(ctx, structType); err != nil {
+			return err
+		}
+	}
+
 	var rows *sql.Rows
 	if ctx != nil {
 		rows, err = q.QueryContext(ctx, exec.(boil.ContextExecutor))
@@ -147,7 +200,7 @@ func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) e
 	if err != nil {
 		return errors.Wrap(err, "bind failed to execute query")
 	}
-	if err = bind(rows, obj, structType, sliceType, bkind); err != nil {
+	if err = bind(rows, obj, structType, sliceType, bkind, joined, q.track); err != nil {
 		if innerErr := rows.Close(); innerErr != nil {
 			return errors.Wrapf(err, "error on rows.Close after bind error: %+v", innerErr)
 		}
@@ -161,10 +214,120 @@ func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) e
 		return errors.Wrap(err, "error from rows in bind")
 	}
 
-	if len(q.load) != 0 {
-		return eagerLoad(ctx, exec, q.load, q.loadMods, obj, bkind)
+	return nil
+}
+
+// DefaultBatchSize is the number of rows BindEach binds at a time when the
+// query has no batch size set.
+var DefaultBatchSize = 100
+
+// BindEach executes the query and binds the rows one at a time instead of
+// holding all of them in memory. obj must be a pointer to a slice of struct
+// pointers, it's filled with up to the batch size of rows (see SetBatchSize)
+// and fn is called for every batch. The relationships the query loads are
+// eager loaded for each batch before fn is called. An error returned by fn
+// stops the iteration and is returned as is.
+//
+// The rows are still open while relationships are loaded and fn is called,
+// so exec must be able to run other queries at the same time, for example
+// a *sql.DB. Most drivers can't do this within a transaction.
+func (q *Query) BindEach(ctx context.Context, exec boil.Executor, obj interface{}, fn func() error) error {
+	structType, _, bkind, err := bindChecks(obj)
+	if err != nil {
+		return err
+	}
+	if bkind != kindPtrSliceStruct {
+		return errors.Errorf("obj type should be *[]*Type but was %q", reflect.TypeOf(obj).String())
+	}
+
+	batchSize := q.batchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	var joined joinedLoads
+	if len(q.loadJoined) != 0 {
+		if q, joined, err = q.joinLoads(ctx, structType); err != nil {
+			return err
+		}
+	}
+
+	var rows *sql.Rows
+	if ctx != nil {
+		rows, err = q.QueryContext(ctx, exec.(boil.ContextExecutor))
+	} else {
+		rows, err = q.Query(exec)
+	}
+	if err != nil {
+		return errors.Wrap(err, "bind each failed to execute query")
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return errors.Wrap(err, "bind each failed to get column names")
+	}
+	mapping, err := getMappingCache(structType).mapping(cols)
+	if err != nil {
+		return err
+	}
+	var joinedBind *joinedBinder
+	if joined != nil {
+		joinedBind = joined.binder(cols, q.track)
+	}
+
+	batch := reflect.Indirect(reflect.ValueOf(obj))
+	flush := func() error {
+		if joined != nil {
+			if err := joined.afterBind(ctx, exec, obj, bkind, 0); err != nil {
+				return err
+			}
+		}
+		if len(q.load) != 0 || len(q.loadCounts) != 0 {
+			if err := q.eagerLoad(ctx, exec, obj, bkind); err != nil {
+				return err
+			}
+		}
+		if err := fn(); err != nil {
+			return err
+		}
+		// A new slice so the rows of the batch aren't kept alive
+		batch.Set(reflect.MakeSlice(batch.Type(), 0, batchSize))
+		return nil
 	}
 
+	track := q.track && isTracker(structType)
+	batch.Set(reflect.MakeSlice(batch.Type(), 0, batchSize))
+	for rows.Next() {
+		newStruct := makeStructPtr(structType)
+		pointers := PtrsFromMapping(reflect.Indirect(newStruct), mapping)
+		if joinedBind != nil {
+			joinedBind.pointers(pointers)
+		}
+		if err = rows.Scan(pointers...); err != nil {
+			return errors.Wrap(err, "failed to bind pointers to obj")
+		}
+		if joinedBind != nil {
+			joinedBind.bind(reflect.Indirect(newStruct))
+		}
+		if track {
+			snapshot(newStruct)
+		}
+		batch.Set(reflect.Append(batch, newStruct))
+
+		if batch.Len() == batchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "error from rows in bind each")
+	}
+
+	if batch.Len() != 0 {
+		return flush()
+	}
 	return nil
 }
 
@@ -223,7 +386,7 @@ func bindChecks(obj interface{}) (structType reflect.Type, sliceType reflect.Typ
 	}
 }
 
-func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, bkind bindKind) error {
+func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, bkind bindKind, joined joinedLoads, trackJoined bool) error {
 	cols, err := rows.Columns()
 	if err != nil {
 		return errors.Wrap(err, "bind failed to get column names")
@@ -244,6 +407,10 @@ func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, b
 	if bkind == kindSliceStruct {
 		oneStruct = reflect.Indirect(reflect.New(structType))
 	}
+	var joinedBind *joinedBinder
+	if joined != nil {
+		joinedBind = joined.binder(cols, trackJoined)
+	}
 
 	foundOne := false
 Rows:
@@ -264,11 +431,26 @@ Rows:
 		if err != nil {
 			return err
 		}
+		if joinedBind != nil {
+			joinedBind.pointers(pointers)
+		}
 
 		if err := rows.Scan(pointers...); err != nil {
 			return errors.Wrap(err, "failed to bind pointers to obj")
 		}
 
+		if joinedBind != nil {
+			switch bkind {
+			case kindStruct:
+				joinedBind.bind(reflect.Indirect(reflect.ValueOf(obj)))
+			case kindSliceStruct:
+				joinedBind.reset(oneStruct)
+				joinedBind.bind(oneStruct)
+			case kindPtrSliceStruct:
+				joinedBind.bind(reflect.Indirect(newStruct))
+			}
+		}
+
 		switch bkind {
 		case kindStruct:
 			break Rows
@@ -354,6 +536,15 @@ func ValuesFromMapping(val reflect.Value, mapping []uint64) []interface{} {
 	return ptrs
 }
 
+// CopyFromMapping expects to be passed two addressable structs of the same
+// type and a mapping of where to find things. It copies the values referred
+// to by the mapping from src to dst.
+func CopyFromMapping(dst, src reflect.Value, mapping []uint64) {
+	for _, m := range mapping {
+		ptrFromMapping(dst, m, true).Elem().Set(ptrFromMapping(src, m, false))
+	}
+}
+
 // ptrFromMapping expects to be passed an addressable struct that it's looking
 // for things on.
 func ptrFromMapping(val reflect.Value, mapping uint64, addressOf bool) reflect.Value {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/drivers"
+	"github.com/friendsofgo/errors"
 
 	"github.com/DATA-DOG/go-sqlmock"
 )
@@ -181,6 +182,178 @@ func TestBindPtrSlice(t *testing.T) {
 	}
 }
 
+type trackedRow struct {
+	ID   int
+	Name string `boil:"test"`
+
+	loaded *trackedRow `boil:"-"`
+}
+
+func (t *trackedRow) Snapshot() {
+	loaded := *t
+	loaded.loaded = nil
+	t.loaded = &loaded
+}
+
+func TestBindSnapshot(t *testing.T) {
+	t.Parallel()
+
+	query := &Query{
+		from:    []string{"fun"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 4; i++ {
+		ret := sqlmock.NewRows([]string{"id", "test"})
+		ret.AddRow(driver.Value(int64(35)), driver.Value("pat"))
+		ret.AddRow(driver.Value(int64(12)), driver.Value("cat"))
+		mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+	}
+
+	check := func(row trackedRow, id int, name string) {
+		t.Helper()
+		if row.loaded == nil {
+			t.Fatal("row was not snapshotted")
+		}
+		if row.loaded.ID != id || row.loaded.Name != name {
+			t.Error("wrong snapshot:", row.loaded.ID, row.loaded.Name)
+		}
+		if row.loaded.loaded != nil {
+			t.Error("snapshot should not be snapshotted")
+		}
+	}
+
+	var untracked trackedRow
+	if err = query.Bind(context.Background(), db, &untracked); err != nil {
+		t.Fatal(err)
+	}
+	if untracked.loaded != nil {
+		t.Error("rows should only be snapshotted when the query tracks changes")
+	}
+
+	SetTrackChanges(query)
+	var one trackedRow
+	if err = query.Bind(context.Background(), db, &one); err != nil {
+		t.Fatal(err)
+	}
+	check(one, 35, "pat")
+
+	var slice []trackedRow
+	if err = query.Bind(context.Background(), db, &slice); err != nil {
+		t.Fatal(err)
+	}
+	check(slice[0], 35, "pat")
+	check(slice[1], 12, "cat")
+
+	var ptrSlice []*trackedRow
+	if err = query.Bind(context.Background(), db, &ptrSlice); err != nil {
+		t.Fatal(err)
+	}
+	check(*ptrSlice[0], 35, "pat")
+	check(*ptrSlice[1], 12, "cat")
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBindEach(t *testing.T) {
+	t.Parallel()
+
+	var batch []*struct {
+		ID   int
+		Name string `boil:"test"`
+	}
+
+	query := &Query{
+		from:      []string{"fun"},
+		dialect:   &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+		batchSize: 2,
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Error(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "test"})
+	ret.AddRow(driver.Value(int64(35)), driver.Value("pat"))
+	ret.AddRow(driver.Value(int64(12)), driver.Value("cat"))
+	ret.AddRow(driver.Value(int64(7)), driver.Value("hat"))
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+
+	var sizes []int
+	var names []string
+	err = query.BindEach(context.Background(), db, &batch, func() error {
+		sizes = append(sizes, len(batch))
+		for _, o := range batch {
+			names = append(names, o.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(sizes, []int{2, 1}) {
+		t.Error("wrong batch sizes:", sizes)
+	}
+	if !reflect.DeepEqual(names, []string{"pat", "cat", "hat"}) {
+		t.Error("wrong names:", names)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBindEachStop(t *testing.T) {
+	t.Parallel()
+
+	var batch []*struct {
+		ID int
+	}
+
+	query := &Query{
+		from:      []string{"fun"},
+		dialect:   &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+		batchSize: 1,
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Error(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id"})
+	ret.AddRow(driver.Value(int64(35)))
+	ret.AddRow(driver.Value(int64(12)))
+	mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+
+	stop := errors.New("stop")
+	calls := 0
+	err = query.BindEach(context.Background(), db, &batch, func() error {
+		calls++
+		return stop
+	})
+	if err != stop {
+		t.Error("want the error returned by fn, got:", err)
+	}
+	if calls != 1 {
+		t.Error("want fn to be called once, got:", calls)
+	}
+
+	var notSlice struct{ ID int }
+	if err = query.BindEach(context.Background(), db, &notSlice, func() error { return nil }); err == nil {
+		t.Error("expected an error binding to a struct")
+	}
+}
+
 func testMakeMapping(byt ...byte) uint64 {
 	var x uint64
 	for i, b := range byt {
@@ -308,6 +481,54 @@ func TestValuesFromMapping(t *testing.T) {
 	}
 }
 
+func TestCopyFromMapping(t *testing.T) {
+	t.Parallel()
+
+	type NestedPtrs struct {
+		Int         int
+		IntP        *int
+		NestedPtrsP *NestedPtrs
+	}
+
+	src := &NestedPtrs{
+		Int:  5,
+		IntP: new(int),
+		NestedPtrsP: &NestedPtrs{
+			Int:  6,
+			IntP: new(int),
+		},
+	}
+	dst := &NestedPtrs{
+		Int:  1,
+		IntP: new(int),
+		NestedPtrsP: &NestedPtrs{
+			Int:  2,
+			IntP: new(int),
+		},
+	}
+	*src.IntP = 7
+	*dst.NestedPtrsP.IntP = 3
+
+	mapping := []uint64{testMakeMapping(1), testMakeMapping(2, 0), 0}
+	CopyFromMapping(reflect.Indirect(reflect.ValueOf(dst)), reflect.Indirect(reflect.ValueOf(src)), mapping)
+
+	if got := dst.Int; got != 1 {
+		t.Error("unmapped int was changed:", got)
+	}
+	if got := *dst.IntP; got != 7 {
+		t.Error("flat pointer was wrong:", got)
+	}
+	if got := dst.NestedPtrsP.Int; got != 6 {
+		t.Error("nested int was wrong:", got)
+	}
+	if got := *dst.NestedPtrsP.IntP; got != 3 {
+		t.Error("unmapped nested pointer was changed:", got)
+	}
+	if dst.IntP == src.IntP {
+		t.Error("pointer was copied instead of its value")
+	}
+}
+
 func TestPtrsFromMapping(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,185 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// tenantScope filters a query to the rows of the tenant in the context the
+// query is run with
+type tenantScope struct {
+	column string
+	all    bool
+	tenant interface{}
+}
+
+// SetTenantColumn scopes the query to the tenant of the context it's run
+// with by filtering on the column. The query fails with boil.ErrNoTenant
+// when the context has no tenant, unless SetAllTenants is used.
+func SetTenantColumn(q *Query, column string) {
+	q.tenant.column = column
+}
+
+// SetAllTenants removes the tenant scope of the query, its subqueries and
+// the relationships it eager loads.
+func SetAllTenants(q *Query) {
+	q.tenant.all = true
+}
+
+// TenantWhere returns the filter on the tenant column to append to the where
+// clause of a statement on a table scoped to tenants, and its arg. The
+// placeholder of the arg is numbered start with index placeholders. Both are
+// empty when the context was made for all tenants, boil.ErrNoTenant is
+// returned when it has no tenant.
+func TenantWhere(ctx context.Context, dialect *drivers.Dialect, column string, start int) (string, []interface{}, error) {
+	if boil.IsAllTenants(ctx) {
+		return "", nil, nil
+	}
+	tenant, ok := boil.TenantFrom(ctx)
+	if !ok {
+		return "", nil, boil.ErrNoTenant
+	}
+
+	placeholder := "?"
+	if dialect.UseIndexPlaceholders {
+		placeholder = fmt.Sprintf("$%d", start)
+	}
+	return fmt.Sprintf(" AND %s = %s", strmangle.IdentQuote(dialect.LQ, dialect.RQ, column), placeholder), []interface{}{tenant}, nil
+}
+
+// eagerLoadContext returns the context to eager load the relationships of
+// the query with, it's not scoped to a tenant when the query isn't.
+func (q *Query) eagerLoadContext(ctx context.Context) context.Context {
+	if !q.tenant.all {
+		return ctx
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return boil.WithAllTenants(ctx)
+}
+
+// scopeTenant returns the query to build for the context, scoped to its
+// tenant along with its subqueries. When nothing is scoped it's q itself so
+// its built SQL is reused, otherwise it's a copy so q can be run again with
+// another tenant.
+func (q *Query) scopeTenant(ctx context.Context) (*Query, error) {
+	all := q.tenant.all || boil.IsAllTenants(ctx)
+	if q.tenant.all {
+		ctx = boil.WithAllTenants(ctx)
+	}
+
+	scoped := *q
+	changed := false
+	if q.tenant.column != "" {
+		if all {
+			changed = !q.tenant.all
+			scoped.tenant.all = true
+		} else {
+			tenant, ok := boil.TenantFrom(ctx)
+			if !ok {
+				return nil, boil.ErrNoTenant
+			}
+			scoped.tenant.tenant = tenant
+			changed = true
+		}
+	}
+
+	// Scoped subqueries are replaced by their copies in copies of the slices
+	// holding them
+	copiedWhere, copiedFrom, copiedSetOps := false, false, false
+	for i, w := range q.where {
+		if w.query == nil {
+			continue
+		}
+		sub, err := w.query.scopeTenant(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if sub != w.query {
+			if !copiedWhere {
+				scoped.where = append([]where(nil), q.where...)
+				copiedWhere = true
+			}
+			scoped.where[i].query = sub
+			changed = true
+		}
+	}
+	for i, f := range q.fromQueries {
+		sub, err := f.query.scopeTenant(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if sub != f.query {
+			if !copiedFrom {
+				scoped.fromQueries = append([]fromQuery(nil), q.fromQueries...)
+				copiedFrom = true
+			}
+			scoped.fromQueries[i].query = sub
+			changed = true
+		}
+	}
+	for i, s := range q.setOps {
+		sub, err := s.query.scopeTenant(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if sub != s.query {
+			if !copiedSetOps {
+				scoped.setOps = append([]setOp(nil), q.setOps...)
+				copiedSetOps = true
+			}
+			scoped.setOps[i].query = sub
+			changed = true
+		}
+	}
+
+	if !changed {
+		return q, nil
+	}
+
+	// Building removes where clauses in place, so the copy gets its own
+	if !copiedWhere {
+		scoped.where = append([]where(nil), q.where...)
+	}
+	scoped.rawSQL = rawSQL{}
+	return &scoped, nil
+}
+
+// AssignTenant sets dst, a pointer to a tenant column, to the tenant. It's
+// converted to the type of the column when it's a different numeric or
+// string type, or scanned when the column is a sql.Scanner.
+func AssignTenant(dst, tenant interface{}) error {
+	dstVal := reflect.ValueOf(dst).Elem()
+	val := reflect.ValueOf(tenant)
+
+	switch {
+	case val.Type().AssignableTo(dstVal.Type()):
+		dstVal.Set(val)
+	case isNumeric(tenant) && isNumeric(dstVal.Interface()), val.Kind() == reflect.String && dstVal.Kind() == reflect.String:
+		dstVal.Set(val.Convert(dstVal.Type()))
+	default:
+		scanner, ok := dst.(sql.Scanner)
+		if !ok {
+			return errors.Errorf("can't assign tenant of type %T to column of type %s", tenant, dstVal.Type())
+		}
+		if valuer, ok := tenant.(driver.Valuer); ok {
+			v, err := valuer.Value()
+			if err != nil {
+				return err
+			}
+			return scanner.Scan(v)
+		}
+		return scanner.Scan(upgradeNumericTypes(tenant))
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/drivers"
+)
+
+func TestTenantWhereClause(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		q      Query
+		expect string
+		args   []interface{}
+	}{
+		{
+			q:      Query{tenant: tenantScope{column: `"t"."tenant_id"`, tenant: 5}},
+			expect: ` WHERE "t"."tenant_id" = $1`,
+			args:   []interface{}{5},
+		},
+		{
+			q: Query{
+				tenant: tenantScope{column: `"t"."tenant_id"`, tenant: 5},
+				where: []where{
+					{clause: "a=?", args: []interface{}{1}},
+					{clause: "b=?", orSeparator: true, args: []interface{}{2}},
+				},
+			},
+			expect: ` WHERE "t"."tenant_id" = $1 AND ((a=$2) OR (b=$3))`,
+			args:   []interface{}{5, 1, 2},
+		},
+		{
+			q: Query{
+				tenant: tenantScope{column: `"t"."tenant_id"`, all: true},
+				where:  []where{{clause: "a=?", args: []interface{}{1}}},
+			},
+			expect: ` WHERE (a=$1)`,
+			args:   []interface{}{1},
+		},
+	}
+
+	for i, test := range tests {
+		test.q.dialect = &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+		result, args := whereClause(&test.q, 1)
+		if result != test.expect {
+			t.Errorf("%d) Mismatch between expect and result:\n%s\n%s\n", i, test.expect, result)
+		}
+		if !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%d) Mismatch between expected args:\n%#v\n%#v\n", i, test.args, args)
+		}
+	}
+}
+
+func TestScopeTenant(t *testing.T) {
+	t.Parallel()
+
+	sub := &Query{}
+	SetTenantColumn(sub, "tenant_id")
+	q := &Query{}
+	AppendWhereSubquery(q, "id in", sub)
+
+	if _, err := q.scopeTenant(context.Background()); err != boil.ErrNoTenant {
+		t.Error("want no tenant error, got:", err)
+	}
+
+	SetSQL(q, "cached")
+	scoped, err := q.scopeTenant(boil.WithTenant(context.Background(), 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scoped == q || scoped.where[0].query.tenant.tenant != 5 {
+		t.Error("want a copy with the subquery scoped to tenant 5")
+	}
+	if len(scoped.rawSQL.sql) != 0 {
+		t.Error("the scoped query should be built again")
+	}
+	if sub.tenant.tenant != nil || q.where[0].query != sub || q.rawSQL.sql != "cached" {
+		t.Error("the query should not be changed")
+	}
+
+	scoped, err = q.scopeTenant(boil.WithAllTenants(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !scoped.where[0].query.tenant.all || sub.tenant.all {
+		t.Error("only the copy of the subquery should be for all tenants")
+	}
+	if _, err = q.scopeTenant(context.Background()); err != boil.ErrNoTenant {
+		t.Error("want no tenant error after running for all tenants, got:", err)
+	}
+
+	SetAllTenants(sub)
+	if scoped, err = q.scopeTenant(context.Background()); err != nil || scoped != q {
+		t.Error("want no scope for all tenants, got:", scoped, err)
+	}
+}
+
+func TestTenantWhere(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	ctx := boil.WithTenant(context.Background(), 5)
+
+	clause, args, err := TenantWhere(ctx, dialect, "tenant_id", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clause != ` AND "tenant_id" = $3` || !reflect.DeepEqual(args, []interface{}{5}) {
+		t.Error("want the filter on tenant 5, got:", clause, args)
+	}
+
+	clause, args, err = TenantWhere(ctx, &drivers.Dialect{LQ: '`', RQ: '`'}, "tenant_id", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clause != " AND `tenant_id` = ?" || !reflect.DeepEqual(args, []interface{}{5}) {
+		t.Error("want the filter on tenant 5, got:", clause, args)
+	}
+
+	clause, args, err = TenantWhere(boil.WithAllTenants(ctx), dialect, "tenant_id", 3)
+	if err != nil || clause != "" || len(args) != 0 {
+		t.Error("want no filter for all tenants, got:", clause, args, err)
+	}
+
+	if _, _, err = TenantWhere(context.Background(), dialect, "tenant_id", 3); err != boil.ErrNoTenant {
+		t.Error("want no tenant error, got:", err)
+	}
+}
+
+func TestAssignTenant(t *testing.T) {
+	t.Parallel()
+
+	var i int64
+	if err := AssignTenant(&i, 5); err != nil || i != 5 {
+		t.Error("want 5, got:", i, err)
+	}
+
+	var s string
+	if err := AssignTenant(&s, "acme"); err != nil || s != "acme" {
+		t.Error("want acme, got:", s, err)
+	}
+
+	var n sql.NullInt64
+	if err := AssignTenant(&n, 7); err != nil || !n.Valid || n.Int64 != 7 {
+		t.Error("want 7, got:", n, err)
+	}
+
+	if err := AssignTenant(&i, "acme"); err == nil {
+		t.Error("want an error assigning a string to an int")
+	}
+}
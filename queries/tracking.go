@@ -0,0 +1,47 @@
+package queries
+
+import "reflect"
+
+// Tracker is implemented by objects that remember the column values they were
+// loaded with to tell which of them were changed since. Bind calls Snapshot
+// on every object it binds a row to when the query tracks changes, see
+// SetTrackChanges.
+type Tracker interface {
+	Snapshot()
+}
+
+// SetTrackChanges makes the objects bound by the query, including the
+// relationships it joins, remember the values they were loaded with. It's
+// opt-in since every object keeps a copy of itself.
+func SetTrackChanges(q *Query) {
+	q.track = true
+}
+
+var trackerType = reflect.TypeOf((*Tracker)(nil)).Elem()
+
+// isTracker checks if pointers to the struct type implement Tracker.
+func isTracker(structType reflect.Type) bool {
+	return reflect.PtrTo(structType).Implements(trackerType)
+}
+
+// snapshot calls Snapshot on the struct val points to.
+func snapshot(val reflect.Value) {
+	val.Interface().(Tracker).Snapshot()
+}
+
+// snapshotBound calls Snapshot on the objects bound to obj from index from on.
+func snapshotBound(obj interface{}, bkind bindKind, from int) {
+	val := reflect.Indirect(reflect.ValueOf(obj))
+	switch bkind {
+	case kindStruct:
+		snapshot(val.Addr())
+	case kindSliceStruct:
+		for i := from; i < val.Len(); i++ {
+			snapshot(val.Index(i).Addr())
+		}
+	case kindPtrSliceStruct:
+		for i := from; i < val.Len(); i++ {
+			snapshot(val.Index(i))
+		}
+	}
+}
@@ -49,6 +49,9 @@ type {{$alias.UpSingular}} struct {
 	R *{{$alias.DownSingular}}R `{{generateTags $.Tags $.RelationTag}}boil:"{{$.RelationTag}}" json:"{{$.RelationTag}}" toml:"{{$.RelationTag}}" yaml:"{{$.RelationTag}}"`
 	L {{$alias.DownSingular}}L `{{generateIgnoreTags $.Tags}}boil:"-" json:"-" toml:"-" yaml:"-"`
 	{{end -}}
+	{{- if not .Table.IsView}}
+	tracking *{{$alias.DownSingular}}Tracking `boil:"-"`
+	{{end -}}
 }
 
 var {{$alias.UpSingular}}Columns = struct {
@@ -119,18 +122,112 @@ func (w {{$name}}) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.fi
 	{{end -}}
 {{- end}}
 
+{{- $hasRelWhere := not (or .Table.IsJoinTable .Table.IsView)}}
+{{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- /* The related rows of relationships of a table to itself are aliased in
+	their subqueries, the table name would be ambiguous */ -}}
+{{- $relatedTable := printf "%s_related" .Table.Name | .Quotes}}
+{{- $hasSelfRelWhere := false}}
+{{- if $hasRelWhere}}
+	{{- range .Table.FKeys}}{{if eq .ForeignTable $.Table.Name}}{{$hasSelfRelWhere = true}}{{end}}{{end}}
+	{{- range .Table.ToOneRelationships}}{{if eq .ForeignTable $.Table.Name}}{{$hasSelfRelWhere = true}}{{end}}{{end}}
+	{{- range .Table.ToManyRelationships}}{{if eq .ForeignTable $.Table.Name}}{{$hasSelfRelWhere = true}}{{end}}{{end}}
+{{- end}}
 var {{$alias.UpSingular}}Where = struct {
 	{{range $column := .Table.Columns -}}
 	{{- $colAlias := $alias.Column $column.Name -}}
 	{{$colAlias}} whereHelper{{goVarname $column.Type}}
 	{{end -}}
+	{{if $hasRelWhere -}}
+	{{range .Table.FKeys -}}
+	{{- $relAlias := $alias.Relationship .Name -}}
+	{{$relAlias.Foreign}} whereRelationHelper
+	{{end -}}
+	{{range .Table.ToOneRelationships -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable -}}
+	{{- $relAlias := $ftable.Relationship .Name -}}
+	{{$relAlias.Local}} whereRelationHelper
+	{{end -}}
+	{{range .Table.ToManyRelationships -}}
+	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
+	{{$relAlias.Local}} whereRelationHelper
+	{{end -}}
+	{{end -}}
 }{
 	{{range $column := .Table.Columns -}}
 	{{- $colAlias := $alias.Column $column.Name -}}
 	{{$colAlias}}: whereHelper{{goVarname $column.Type}}{field: "{{$.Table.Name | $.SchemaTable}}.{{$column.Name | $.Quotes}}"},
 	{{end -}}
+	{{if $hasRelWhere -}}
+	{{range .Table.FKeys -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable -}}
+	{{- $relAlias := $alias.Relationship .Name -}}
+	{{$relAlias.Foreign}}: whereRelationHelper{query: func(mods ...qm.QueryMod) *queries.Query {
+		{{if eq .ForeignTable $.Table.Name -}}
+		return {{$alias.DownSingular}}RelatedQuery(append([]qm.QueryMod{
+			qm.Where("{{$relatedTable}}.{{.ForeignColumn | $.Quotes}} = {{$schemaTable}}.{{.Column | $.Quotes}}"),
+		}, mods...)...)
+		{{- else -}}
+		return {{$ftable.UpPlural}}(append([]qm.QueryMod{
+			qm.Where("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}} = {{$schemaTable}}.{{.Column | $.Quotes}}"),
+		}, mods...)...).Query
+		{{- end}}
+	}},
+	{{end -}}
+	{{range .Table.ToOneRelationships -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable -}}
+	{{- $relAlias := $ftable.Relationship .Name -}}
+	{{$relAlias.Local}}: whereRelationHelper{query: func(mods ...qm.QueryMod) *queries.Query {
+		{{if eq .ForeignTable $.Table.Name -}}
+		return {{$alias.DownSingular}}RelatedQuery(append([]qm.QueryMod{
+			qm.Where("{{$relatedTable}}.{{.ForeignColumn | $.Quotes}} = {{$schemaTable}}.{{.Column | $.Quotes}}"),
+		}, mods...)...)
+		{{- else -}}
+		return {{$ftable.UpPlural}}(append([]qm.QueryMod{
+			qm.Where("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}} = {{$schemaTable}}.{{.Column | $.Quotes}}"),
+		}, mods...)...).Query
+		{{- end}}
+	}},
+	{{end -}}
+	{{range .Table.ToManyRelationships -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable -}}
+	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
+	{{- $self := eq .ForeignTable $.Table.Name -}}
+	{{- $schemaForeignTable := .ForeignTable | $.SchemaTable -}}
+	{{- if $self}}{{$schemaForeignTable = $relatedTable}}{{end -}}
+	{{$relAlias.Local}}: whereRelationHelper{query: func(mods ...qm.QueryMod) *queries.Query {
+		return {{if $self}}{{$alias.DownSingular}}RelatedQuery{{else}}{{$ftable.UpPlural}}{{end}}(append([]qm.QueryMod{
+			{{if .ToJoinTable -}}
+			{{- $schemaJoinTable := .JoinTable | $.SchemaTable -}}
+			qm.InnerJoin("{{$schemaJoinTable}} on {{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}} = {{$schemaJoinTable}}.{{.JoinForeignColumn | $.Quotes}}"),
+			qm.Where("{{$schemaJoinTable}}.{{.JoinLocalColumn | $.Quotes}} = {{$schemaTable}}.{{.Column | $.Quotes}}"),
+			{{- else -}}
+			qm.Where("{{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}} = {{$schemaTable}}.{{.Column | $.Quotes}}"),
+			{{- end}}
+		}, mods...)...){{if not $self}}.Query{{end}}
+	}},
+	{{end -}}
+	{{end -}}
 }
 
+{{if $hasSelfRelWhere -}}
+{{- $canSoftDelete := .Table.CanSoftDelete $.AutoColumns.Deleted -}}
+{{- $hasTenant := .Table.HasTenant $.AutoColumns.Tenant -}}
+// {{$alias.DownSingular}}RelatedQuery is the query for the related rows of the where helpers
+// of relationships of {{.Table.Name}} to itself. The related rows are aliased as
+// {{.Table.Name}}_related so they can be told apart from the outer row, their mods must use it.
+func {{$alias.DownSingular}}RelatedQuery(mods ...qm.QueryMod) *queries.Query {
+	q := NewQuery(append([]qm.QueryMod{qm.From("{{$schemaTable}} AS {{$relatedTable}}")}, mods...)...)
+	{{- if and .AddSoftDeletes $canSoftDelete}}
+	queries.SetSoftDeleteColumn(q, "{{$relatedTable}}.{{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}}")
+	{{- end}}
+	{{- if $hasTenant}}
+	queries.SetTenantColumn(q, "{{$relatedTable}}.{{$.AutoColumns.Tenant | $.Quotes}}")
+	{{- end}}
+	return q
+}
+
+{{end -}}
 {{if or .Table.IsJoinTable .Table.IsView -}}
 {{- else -}}
 // {{$alias.UpSingular}}Rels is where relationship names are stored.
@@ -187,6 +284,12 @@ type {{$alias.DownSingular}}R struct {
 	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
 	{{$relAlias.Local}} {{printf "%sSlice" $ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Local}}boil:"{{$relAlias.Local}}" json:"{{$relAlias.Local}}" toml:"{{$relAlias.Local}}" yaml:"{{$relAlias.Local}}"`
 	{{end -}}{{/* range tomany */}}
+	{{- if .Table.ToManyRelationships}}
+
+	// counts are the numbers of related rows counted by qm.LoadCount, by
+	// relationship name
+	counts map[string]int64
+	{{- end}}
 }
 
 // NewStruct creates a new relationship struct
@@ -270,6 +373,16 @@ func (r *{{$alias.DownSingular}}R) Get{{$relAlias.Local}}() {{printf "%sSlice" $
 	return r.{{$relAlias.Local}}
 }
 
+// Count{{$relAlias.Local}} returns the number of {{$relAlias.Local}} counted by qm.LoadCount,
+// 0 when they weren't counted.
+func (r *{{$alias.DownSingular}}R) Count{{$relAlias.Local}}() int64 {
+	if (r == nil) {
+		return 0
+	}
+
+	return r.counts[{{$alias.UpSingular}}Rels.{{$relAlias.Local}}]
+}
+
 {{end -}}
 
 // {{$alias.DownSingular}}L is where Load methods for each relationship are stored.
@@ -13,6 +13,14 @@ var (
 	{{$alias.DownSingular}}GeneratedColumns = []string{{"{"}}{{.Table.Columns | filterColumnsByAuto true | columnNames | stringMap .StringFuncs.quoteWrap | join ","}}{{"}"}}
 )
 
+{{- $sensitive := $.SensitiveColumns .Table}}
+{{- if $sensitive}}
+
+func init() {
+	boil.SetSensitiveColumns("{{.Table.Name}}", {{$sensitive | stringMap .StringFuncs.quoteWrap | join ", "}})
+}
+{{- end}}
+
 type (
 	// {{$alias.UpSingular}}Slice is an alias for a slice of pointers to {{$alias.UpSingular}}.
 	// This should almost always be used instead of []{{$alias.UpSingular}}.
@@ -36,6 +36,9 @@ func (q {{$alias.DownSingular}}Query) OneP({{if .NoContext}}exec boil.Executor{{
 
 // One returns a single {{$alias.DownSingular}} record from the query.
 func (q {{$alias.DownSingular}}Query) One({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (*{{$alias.UpSingular}}, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
 	o := &{{$alias.UpSingular}}{}
 
 	queries.SetLimit(q.Query, 1)
@@ -95,6 +98,9 @@ func (q {{$alias.DownSingular}}Query) AllP({{if .NoContext}}exec boil.Executor{{
 
 // All returns all {{$alias.UpSingular}} records from the query.
 func (q {{$alias.DownSingular}}Query) All({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) ({{$alias.UpSingular}}Slice, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
 	var o []*{{$alias.UpSingular}}
 
 	err := q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &o)
@@ -115,6 +121,95 @@ func (q {{$alias.DownSingular}}Query) All({{if .NoContext}}exec boil.Executor{{e
 	return o, nil
 }
 
+{{if .AddGlobal -}}
+// EachG calls fn with every {{$alias.UpSingular}} record from the query using the global executor.
+// See Each for details.
+func (q {{$alias.DownSingular}}Query) EachG({{if not .NoContext}}ctx context.Context, {{end -}} fn func(*{{$alias.UpSingular}}) error) error {
+	return q.Each({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, fn)
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// EachP calls fn with every {{$alias.UpSingular}} record from the query, and panics on error.
+// See Each for details.
+func (q {{$alias.DownSingular}}Query) EachP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, fn func(*{{$alias.UpSingular}}) error) {
+	if err := q.Each({{if not .NoContext}}ctx, {{end -}} exec, fn); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// Each calls fn with every {{$alias.UpSingular}} record from the query as they're read,
+// instead of holding all of them in memory like All. Relationships set with
+// qm.Load are loaded for batches of records, qm.BatchSize sets how many.
+// An error returned by fn stops the iteration and is returned.
+//
+// The rows stay open during the iteration so relationships can only be loaded
+// when exec can run other queries at the same time, like a *sql.DB.
+func (q {{$alias.DownSingular}}Query) Each({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, fn func(*{{$alias.UpSingular}}) error) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
+	var batch []*{{$alias.UpSingular}}
+
+	var fnErr error
+	err := q.BindEach({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &batch, func() error {
+		for _, o := range batch {
+			{{if not .NoHooks -}}
+			if err := o.doAfterSelectHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+				return err
+			}
+			{{end -}}
+			if fnErr = fn(o); fnErr != nil {
+				return fnErr
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if fnErr != nil {
+			return fnErr
+		}
+		return errors.Wrap(err, "{{.PkgName}}: failed to iterate over {{$alias.UpSingular}} query results")
+	}
+
+	return nil
+}
+
+{{if .AddGlobal -}}
+// IterG returns an iterator over the {{$alias.UpSingular}} records from the query using the global executor.
+// See Iter for details.
+func (q {{$alias.DownSingular}}Query) IterG({{if not .NoContext}}ctx context.Context{{end}}) iter.Seq2[*{{$alias.UpSingular}}, error] {
+	return q.Iter({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end -}})
+}
+
+{{end -}}
+
+// Iter returns an iterator over the {{$alias.UpSingular}} records from the query that reads
+// them as the loop goes like Each. An error ends the iteration, it's yielded
+// with a nil record:
+//
+//	for o, err := range q.Iter(ctx, db) {
+//		if err != nil {
+//			return err
+//		}
+//	}
+func (q {{$alias.DownSingular}}Query) Iter({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) iter.Seq2[*{{$alias.UpSingular}}, error] {
+	return func(yield func(*{{$alias.UpSingular}}, error) bool) {
+		err := q.Each({{if not .NoContext}}ctx, {{end -}} exec, func(o *{{$alias.UpSingular}}) error {
+			if !yield(o, nil) {
+				return errStopIteration
+			}
+			return nil
+		})
+		if err != nil && err != errStopIteration {
+			yield(nil, err)
+		}
+	}
+}
+
 {{if .AddGlobal -}}
 // CountG returns the count of all {{$alias.UpSingular}} records in the query using the global executor
 func (q {{$alias.DownSingular}}Query) CountG({{if not .NoContext}}ctx context.Context{{end}}) (int64, error) {
@@ -151,6 +246,9 @@ func (q {{$alias.DownSingular}}Query) CountP({{if .NoContext}}exec boil.Executor
 
 // Count returns the count of all {{$alias.UpSingular}} records in the query.
 func (q {{$alias.DownSingular}}Query) Count({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (int64, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
 	var count int64
 
 	queries.SetSelect(q.Query, nil)
@@ -204,6 +302,9 @@ func (q {{$alias.DownSingular}}Query) ExistsP({{if .NoContext}}exec boil.Executo
 
 // Exists checks if the row exists in the table.
 func (q {{$alias.DownSingular}}Query) Exists({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (bool, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
 	var count int64
 
 	queries.SetSelect(q.Query, nil)
@@ -221,3 +322,267 @@ func (q {{$alias.DownSingular}}Query) Exists({{if .NoContext}}exec boil.Executor
 
 	return count > 0, nil
 }
+
+{{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- range $column := .Table.Columns}}
+{{- $colAlias := $alias.Column $column.Name}}
+{{- range $fn := aggregateFuncs}}
+{{- $type := aggregateType $fn.SQL $column.Type}}
+{{- if $type}}
+{{if $.AddGlobal -}}
+// {{$fn.Name}}{{$colAlias}}G returns the {{$fn.SQL}} of the {{$column.Name}} column of the records in the query using the global executor.
+func (q {{$alias.DownSingular}}Query) {{$fn.Name}}{{$colAlias}}G({{if not $.NoContext}}ctx context.Context{{end}}) ({{$type}}, error) {
+	return q.{{$fn.Name}}{{$colAlias}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end -}})
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// {{$fn.Name}}{{$colAlias}}GP returns the {{$fn.SQL}} of the {{$column.Name}} column of the records in the query using the global executor, and panics on error.
+func (q {{$alias.DownSingular}}Query) {{$fn.Name}}{{$colAlias}}GP({{if not $.NoContext}}ctx context.Context{{end}}) {{$type}} {
+	v, err := q.{{$fn.Name}}{{$colAlias}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end -}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return v
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// {{$fn.Name}}{{$colAlias}}P returns the {{$fn.SQL}} of the {{$column.Name}} column of the records in the query, and panics on error.
+func (q {{$alias.DownSingular}}Query) {{$fn.Name}}{{$colAlias}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) {{$type}} {
+	v, err := q.{{$fn.Name}}{{$colAlias}}({{if not $.NoContext}}ctx, {{end -}} exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return v
+}
+
+{{end -}}
+
+// {{$fn.Name}}{{$colAlias}} returns the {{$fn.SQL}} of the {{$column.Name}} column of the records in the query.
+// It's null when there are no records.
+func (q {{$alias.DownSingular}}Query) {{$fn.Name}}{{$colAlias}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) ({{$type}}, error) {
+	var v {{$type}}
+	err := q.aggregate({{if not $.NoContext}}ctx, {{end -}} exec, "{{$fn.SQL}}({{$schemaTable}}.{{$column.Name | $.Quotes}})", &v)
+	return v, err
+}
+{{end -}}
+{{end -}}
+{{- if or (isPrimitive $column.Type) (isNullPrimitive $column.Type) (eq $column.Type "bool" "null.Bool")}}
+{{if $.AddGlobal -}}
+// GroupCount{{$colAlias}}G counts the records in the query for every value of the {{$column.Name}} column using the global executor.
+func (q {{$alias.DownSingular}}Query) GroupCount{{$colAlias}}G({{if not $.NoContext}}ctx context.Context{{end}}) (map[{{$column.Type}}]int64, error) {
+	return q.GroupCount{{$colAlias}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end -}})
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// GroupCount{{$colAlias}}GP counts the records in the query for every value of the {{$column.Name}} column using the global executor, and panics on error.
+func (q {{$alias.DownSingular}}Query) GroupCount{{$colAlias}}GP({{if not $.NoContext}}ctx context.Context{{end}}) map[{{$column.Type}}]int64 {
+	counts, err := q.GroupCount{{$colAlias}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end -}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return counts
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// GroupCount{{$colAlias}}P counts the records in the query for every value of the {{$column.Name}} column, and panics on error.
+func (q {{$alias.DownSingular}}Query) GroupCount{{$colAlias}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) map[{{$column.Type}}]int64 {
+	counts, err := q.GroupCount{{$colAlias}}({{if not $.NoContext}}ctx, {{end -}} exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return counts
+}
+
+{{end -}}
+
+// GroupCount{{$colAlias}} counts the records in the query for every value of the {{$column.Name}} column.
+func (q {{$alias.DownSingular}}Query) GroupCount{{$colAlias}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (map[{{$column.Type}}]int64, error) {
+	{{if not $.NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{$.Table.Name}}", boil.SelectOperation)
+	{{end -}}
+	column := "{{$schemaTable}}.{{$column.Name | $.Quotes}}"
+	queries.SetSelect(q.Query, []string{column, "COUNT(*)"})
+	queries.AppendGroupBy(q.Query, column)
+
+	{{if $.NoContext -}}
+	rows, err := q.Query.Query(exec)
+	{{else -}}
+	rows, err := q.Query.QueryContext(ctx, exec)
+	{{end -}}
+	if err != nil {
+		return nil, errors.Wrap(err, "{{$.PkgName}}: failed to group count {{$.Table.Name}} by {{$column.Name}}")
+	}
+	defer rows.Close()
+
+	counts := make(map[{{$column.Type}}]int64)
+	for rows.Next() {
+		var value {{$column.Type}}
+		var count int64
+		if err = rows.Scan(&value, &count); err != nil {
+			return nil, errors.Wrap(err, "{{$.PkgName}}: failed to scan group count of {{$.Table.Name}} by {{$column.Name}}")
+		}
+		counts[value] = count
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "{{$.PkgName}}: failed to group count {{$.Table.Name}} by {{$column.Name}}")
+	}
+
+	return counts, nil
+}
+{{end -}}
+{{- end}}
+
+// aggregate selects the aggregate expression over the records in the query
+// into dest.
+func (q {{$alias.DownSingular}}Query) aggregate({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, expr string, dest interface{}) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
+	queries.SetSelect(q.Query, []string{expr})
+
+	{{if .NoContext -}}
+	err := q.Query.QueryRow(exec).Scan(dest)
+	{{else -}}
+	err := q.Query.QueryRowContext(ctx, exec).Scan(dest)
+	{{end -}}
+	if err != nil {
+		return errors.Wrapf(err, "{{.PkgName}}: failed to select %s from {{.Table.Name}}", expr)
+	}
+
+	return nil
+}
+
+{{if .AddGlobal -}}
+// ExplainG returns the plan the database chose for the query using the global executor.
+func (q {{$alias.DownSingular}}Query) ExplainG({{if not .NoContext}}ctx context.Context, {{end -}} opts queries.ExplainOptions) (*queries.Plan, error) {
+	return q.Explain({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, opts)
+}
+
+{{end -}}
+
+{{if and .AddGlobal .AddPanic -}}
+// ExplainGP returns the plan the database chose for the query using the global executor, and panics on error.
+func (q {{$alias.DownSingular}}Query) ExplainGP({{if not .NoContext}}ctx context.Context, {{end -}} opts queries.ExplainOptions) *queries.Plan {
+	plan, err := q.Explain({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, opts)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return plan
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// ExplainP returns the plan the database chose for the query, and panics on error.
+func (q {{$alias.DownSingular}}Query) ExplainP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, opts queries.ExplainOptions) *queries.Plan {
+	plan, err := q.Explain({{if not .NoContext}}ctx, {{end -}} exec, opts)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return plan
+}
+
+{{end -}}
+
+// Explain returns the plan the database chose for the query, see
+// queries.Explain for what's run on each database.
+func (q {{$alias.DownSingular}}Query) Explain({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, opts queries.ExplainOptions) (*queries.Plan, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
+	plan, err := queries.Explain({{if .NoContext}}nil{{else}}ctx{{end}}, exec, q.Query, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: failed to explain {{.Table.Name}} query")
+	}
+
+	return plan, nil
+}
+
+{{if .AddGlobal -}}
+// PaginateG returns a single page of {{$alias.UpSingular}} records from the query using the global executor.
+func (q {{$alias.DownSingular}}Query) PaginateG({{if not .NoContext}}ctx context.Context, {{end -}} page qm.QueryMod) ({{$alias.UpSingular}}Slice, queries.Cursors, error) {
+	return q.Paginate({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, page)
+}
+
+{{end -}}
+
+{{if and .AddGlobal .AddPanic -}}
+// PaginateGP returns a single page of {{$alias.UpSingular}} records from the query using the global executor, and panics on error.
+func (q {{$alias.DownSingular}}Query) PaginateGP({{if not .NoContext}}ctx context.Context, {{end -}} page qm.QueryMod) ({{$alias.UpSingular}}Slice, queries.Cursors) {
+	o, cursors, err := q.Paginate({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, page)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o, cursors
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// PaginateP returns a single page of {{$alias.UpSingular}} records from the query, and panics on error.
+func (q {{$alias.DownSingular}}Query) PaginateP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, page qm.QueryMod) ({{$alias.UpSingular}}Slice, queries.Cursors) {
+	o, cursors, err := q.Paginate({{if not .NoContext}}ctx, {{end -}} exec, page)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o, cursors
+}
+
+{{end -}}
+
+// Paginate returns a single page of {{$alias.UpSingular}} records from the query
+// selected with qm.PageAfter or qm.PageBefore, along with the cursors for the
+// pages on either side of it. Rows are ordered by the primary key unless
+// qm.PageBy is used, which can't name nullable columns. The query can't have
+// an OrderBy or Limit of its own.
+func (q {{$alias.DownSingular}}Query) Paginate({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, page qm.QueryMod) ({{$alias.UpSingular}}Slice, queries.Cursors, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
+	var o []*{{$alias.UpSingular}}
+
+	page.Apply(q.Query)
+	err := queries.ApplyPage(q.Query, {{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, {{$alias.DownSingular}}PrimaryKeyColumns,
+		[]string{ {{- range .Table.Columns}}{{if .Nullable}}"{{.Name}}", {{end}}{{end -}} })
+	if err != nil {
+		return nil, queries.Cursors{}, errors.Wrap(err, "{{.PkgName}}: failed to paginate {{.Table.Name}}")
+	}
+
+	err = q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &o)
+	if err != nil {
+		return nil, queries.Cursors{}, errors.Wrap(err, "{{.PkgName}}: failed to assign page query results to {{$alias.UpSingular}} slice")
+	}
+
+	cursors, err := queries.PageCursors(q.Query, &o, {{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping)
+	if err != nil {
+		return nil, queries.Cursors{}, errors.Wrap(err, "{{.PkgName}}: failed to create cursors for {{.Table.Name}}")
+	}
+
+	{{if not .NoHooks -}}
+	if len({{$alias.DownSingular}}AfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+				return o, cursors, err
+			}
+		}
+	}
+	{{- end}}
+
+	return o, cursors, nil
+}
@@ -26,7 +26,14 @@ func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) {{$fta
 	)
 		{{end}}
 
+	{{if $rel.ToJoinTable -}}
+	q := {{$ftable.UpPlural}}(queryMods...)
+	queries.AppendCacheTags(q.Query, "{{$rel.JoinTable}}")
+
+	return q
+	{{else -}}
 	return {{$ftable.UpPlural}}(queryMods...)
+	{{end -}}
 }
 
 {{end -}}{{- /* range relationships */ -}}
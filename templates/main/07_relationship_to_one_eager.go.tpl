@@ -9,6 +9,7 @@
 		{{- $fcol := $ftable.Column $fkey.ForeignColumn -}}
 		{{- $usesPrimitives := usesPrimitives $.Tables $fkey.Table $fkey.Column $fkey.ForeignTable $fkey.ForeignColumn -}}
 		{{- $canSoftDelete := (getTable $.Tables $fkey.ForeignTable).CanSoftDelete $.AutoColumns.Deleted }}
+		{{- $hasTenant := (getTable $.Tables $fkey.ForeignTable).HasTenant $.AutoColumns.Tenant }}
 // Load{{$rel.Foreign}} allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
@@ -79,10 +80,13 @@ func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.E
 	query := NewQuery(
 	    qm.From(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}`),
 	    qm.WhereIn(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{.ForeignColumn}} in ?`, argsSlice...),
-	    {{if and $.AddSoftDeletes $canSoftDelete -}}
-	    qmhelper.WhereIsNull(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{or $.AutoColumns.Deleted "deleted_at"}}`),
-	    {{- end}}
     )
+	{{if and $.AddSoftDeletes $canSoftDelete -}}
+	queries.SetSoftDeleteColumn(query, "{{.ForeignTable | $.SchemaTable}}.{{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}}")
+	{{end -}}
+	{{if $hasTenant -}}
+	queries.SetTenantColumn(query, "{{.ForeignTable | $.SchemaTable}}.{{$.AutoColumns.Tenant | $.Quotes}}")
+	{{end -}}
 	if mods != nil {
 		mods.Apply(query)
 	}
@@ -90,7 +94,7 @@ func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.E
 	{{if $.NoContext -}}
 	results, err := query.Query(e)
 	{{else -}}
-	results, err := query.QueryContext(ctx, e)
+	results, err := query.QueryContext(boil.WithOperation(ctx, "{{.ForeignTable}}", boil.SelectOperation), e)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "failed to eager load {{$ftable.UpSingular}}")
@@ -163,5 +167,36 @@ func ({{$ltable.DownSingular}}L) Load{{$rel.Foreign}}({{if $.NoContext}}e boil.E
 
 	return nil
 }
+
+// Join{{$rel.Foreign}} describes the N-1 relationship for joining it into queries
+// with qm.LoadJoined.
+func ({{$ltable.DownSingular}}L) Join{{$rel.Foreign}}() queries.JoinedRelationship {
+	return queries.JoinedRelationship{
+		Table:         "{{.ForeignTable | $.SchemaTable}}",
+		Column:        "{{.Column}}",
+		ForeignColumn: "{{.ForeignColumn}}",
+		Columns:       {{$ftable.DownSingular}}AllColumns,
+		{{- if and $.AddSoftDeletes $canSoftDelete}}
+		SoftDeleteColumn: "{{or $.AutoColumns.Deleted "deleted_at"}}",
+		{{- end}}
+		{{- if $hasTenant}}
+		TenantColumn: "{{$.AutoColumns.Tenant}}",
+		{{- end}}
+		{{- if not $.NoBackReferencing}}
+		Reverse: "{{$rel.Local}}",
+		{{- end}}
+		{{- if not $.NoHooks}}
+		{{- if $.NoContext}}
+		AfterSelectNoContext: func(exec boil.Executor, o interface{}) error {
+			return o.(*{{$ftable.UpSingular}}).doAfterSelectHooks(exec)
+		},
+		{{- else}}
+		AfterSelect: func(ctx context.Context, exec boil.ContextExecutor, o interface{}) error {
+			return o.(*{{$ftable.UpSingular}}).doAfterSelectHooks(ctx, exec)
+		},
+		{{- end}}
+		{{- end}}
+	}
+}
 {{end -}}{{/* range */}}
 {{end}}{{/* join table */}}
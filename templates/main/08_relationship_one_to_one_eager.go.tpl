@@ -9,6 +9,7 @@
 		{{- $usesPrimitives := usesPrimitives $.Tables $rel.Table $rel.Column $rel.ForeignTable $rel.ForeignColumn -}}
 		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
 		{{- $canSoftDelete := (getTable $.Tables $rel.ForeignTable).CanSoftDelete $.AutoColumns.Deleted }}
+		{{- $hasTenant := (getTable $.Tables $rel.ForeignTable).HasTenant $.AutoColumns.Tenant }}
 // Load{{$relAlias.Local}} allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
@@ -67,10 +68,13 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 	query := NewQuery(
 	    qm.From(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}`),
         qm.WhereIn(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{.ForeignColumn}} in ?`, argsSlice...),
-	    {{if and $.AddSoftDeletes $canSoftDelete -}}
-	    qmhelper.WhereIsNull(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{or $.AutoColumns.Deleted "deleted_at"}}`),
-	    {{- end}}
     )
+	{{if and $.AddSoftDeletes $canSoftDelete -}}
+	queries.SetSoftDeleteColumn(query, "{{.ForeignTable | $.SchemaTable}}.{{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}}")
+	{{end -}}
+	{{if $hasTenant -}}
+	queries.SetTenantColumn(query, "{{.ForeignTable | $.SchemaTable}}.{{$.AutoColumns.Tenant | $.Quotes}}")
+	{{end -}}
 	if mods != nil {
 		mods.Apply(query)
 	}
@@ -78,7 +82,7 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 	{{if $.NoContext -}}
 	results, err := query.Query(e)
 	{{else -}}
-	results, err := query.QueryContext(ctx, e)
+	results, err := query.QueryContext(boil.WithOperation(ctx, "{{.ForeignTable}}", boil.SelectOperation), e)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "failed to eager load {{$ftable.UpSingular}}")
@@ -142,5 +146,36 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 
 	return nil
 }
+
+// Join{{$relAlias.Local}} describes the 1-1 relationship for joining it into queries
+// with qm.LoadJoined.
+func ({{$ltable.DownSingular}}L) Join{{$relAlias.Local}}() queries.JoinedRelationship {
+	return queries.JoinedRelationship{
+		Table:         "{{$rel.ForeignTable | $.SchemaTable}}",
+		Column:        "{{$rel.Column}}",
+		ForeignColumn: "{{$rel.ForeignColumn}}",
+		Columns:       {{$ftable.DownSingular}}AllColumns,
+		{{- if and $.AddSoftDeletes $canSoftDelete}}
+		SoftDeleteColumn: "{{or $.AutoColumns.Deleted "deleted_at"}}",
+		{{- end}}
+		{{- if $hasTenant}}
+		TenantColumn: "{{$.AutoColumns.Tenant}}",
+		{{- end}}
+		{{- if not $.NoBackReferencing}}
+		Reverse: "{{$relAlias.Foreign}}",
+		{{- end}}
+		{{- if not $.NoHooks}}
+		{{- if $.NoContext}}
+		AfterSelectNoContext: func(exec boil.Executor, o interface{}) error {
+			return o.(*{{$ftable.UpSingular}}).doAfterSelectHooks(exec)
+		},
+		{{- else}}
+		AfterSelect: func(ctx context.Context, exec boil.ContextExecutor, o interface{}) error {
+			return o.(*{{$ftable.UpSingular}}).doAfterSelectHooks(ctx, exec)
+		},
+		{{- end}}
+		{{- end}}
+	}
+}
 {{end -}}{{/* range */}}
 {{end}}{{/* join table */}}
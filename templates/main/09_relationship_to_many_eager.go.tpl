@@ -10,6 +10,7 @@
 		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
 		{{- $schemaForeignTable := $rel.ForeignTable | $.SchemaTable -}}
 		{{- $canSoftDelete := (getTable $.Tables $rel.ForeignTable).CanSoftDelete $.AutoColumns.Deleted }}
+		{{- $hasTenant := (getTable $.Tables $rel.ForeignTable).HasTenant $.AutoColumns.Tenant }}
 // Load{{$relAlias.Local}} allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
@@ -72,19 +73,21 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 		qm.From("{{$schemaForeignTable}}"),
 		qm.InnerJoin("{{$schemaJoinTable}} as {{id 0 | $.Quotes}} on {{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}} = {{id 0 | $.Quotes}}.{{.JoinForeignColumn | $.Quotes}}"),
 		qm.WhereIn("{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}} in ?", argsSlice...),
-		{{if and $.AddSoftDeletes $canSoftDelete -}}
-		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}}"),
-		{{- end}}
 	)
+	queries.SetParentColumn(query, "{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}}")
 		{{else -}}
 	query := NewQuery(
 	    qm.From(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}`),
 	    qm.WhereIn(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{.ForeignColumn}} in ?`, argsSlice...),
-	    {{if and $.AddSoftDeletes $canSoftDelete -}}
-	    qmhelper.WhereIsNull(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{or $.AutoColumns.Deleted "deleted_at"}}`),
-	    {{- end}}
     )
+	queries.SetParentColumn(query, "{{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}}")
 		{{end -}}
+	{{if and $.AddSoftDeletes $canSoftDelete -}}
+	queries.SetSoftDeleteColumn(query, "{{.ForeignTable | $.SchemaTable}}.{{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}}")
+	{{end -}}
+	{{if $hasTenant -}}
+	queries.SetTenantColumn(query, "{{.ForeignTable | $.SchemaTable}}.{{$.AutoColumns.Tenant | $.Quotes}}")
+	{{end -}}
 	if mods != nil {
 		mods.Apply(query)
 	}
@@ -92,7 +95,7 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 	{{if $.NoContext -}}
 	results, err := query.Query(e)
 	{{else -}}
-	results, err := query.QueryContext(ctx, e)
+	results, err := query.QueryContext(boil.WithOperation(ctx, "{{.ForeignTable}}", boil.SelectOperation), e)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "failed to eager load {{.ForeignTable}}")
@@ -200,6 +203,126 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 	}
 	{{end}}
 
+	return nil
+}
+
+		{{- $keyType := ((getTable $.Tables $rel.ForeignTable).GetColumn $rel.ForeignColumn).Type -}}
+		{{- if .ToJoinTable -}}
+		{{- $keyType = ((getTable $.Tables .JoinTable).GetColumn .JoinLocalColumn).Type -}}
+		{{- end}}
+
+// Count{{$relAlias.Local}} allows an eager lookup of the number of {{$relAlias.Local}},
+// cached into the loaded structs of the objects without loading the rows.
+// This is for a 1-M or N-M relationship.
+func ({{$ltable.DownSingular}}L) Count{{$relAlias.Local}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var slice []*{{$ltable.UpSingular}}
+
+	if singular {
+		object, ok := {{$arg}}.(*{{$ltable.UpSingular}})
+		if !ok {
+			object = new({{$ltable.UpSingular}})
+			ok = queries.SetFromEmbeddedStruct(&object, &{{$arg}})
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, {{$arg}}))
+			}
+		}
+		slice = []*{{$ltable.UpSingular}}{object}
+	} else {
+		s, ok := {{$arg}}.(*[]*{{$ltable.UpSingular}})
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, {{$arg}})
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, {{$arg}}))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	for _, obj := range slice {
+		if obj.R == nil {
+			obj.R = &{{$ltable.DownSingular}}R{}
+		}
+		if obj.R.counts == nil {
+			obj.R.counts = make(map[string]int64)
+		}
+		obj.R.counts[{{$ltable.UpSingular}}Rels.{{$relAlias.Local}}] = 0
+		args[obj.{{$col}}] = struct{}{}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+		{{if .ToJoinTable -}}
+			{{- $schemaJoinTable := .JoinTable | $.SchemaTable -}}
+	query := NewQuery(
+		qm.Select("{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}}, count(*)"),
+		qm.From("{{$schemaForeignTable}}"),
+		qm.InnerJoin("{{$schemaJoinTable}} as {{id 0 | $.Quotes}} on {{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}} = {{id 0 | $.Quotes}}.{{.JoinForeignColumn | $.Quotes}}"),
+		qm.WhereIn("{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}} in ?", argsSlice...),
+		qm.GroupBy("{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}}"),
+	)
+		{{else -}}
+	query := NewQuery(
+		qm.Select("{{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}}, count(*)"),
+		qm.From("{{$schemaForeignTable}}"),
+		qm.WhereIn("{{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}} in ?", argsSlice...),
+		qm.GroupBy("{{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}}"),
+	)
+		{{end -}}
+	{{if and $.AddSoftDeletes $canSoftDelete -}}
+	queries.SetSoftDeleteColumn(query, "{{.ForeignTable | $.SchemaTable}}.{{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}}")
+	{{end -}}
+	{{if $hasTenant -}}
+	queries.SetTenantColumn(query, "{{.ForeignTable | $.SchemaTable}}.{{$.AutoColumns.Tenant | $.Quotes}}")
+	{{end -}}
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	{{if $.NoContext -}}
+	results, err := query.Query(e)
+	{{else -}}
+	results, err := query.QueryContext(boil.WithOperation(ctx, "{{.ForeignTable}}", boil.SelectOperation), e)
+	{{end -}}
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load {{.ForeignTable}} counts")
+	}
+
+	for results.Next() {
+		var key {{$keyType}}
+		var count int64
+		if err = results.Scan(&key, &count); err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded counts for {{.ForeignTable}}")
+		}
+
+		for _, local := range slice {
+			{{if $usesPrimitives -}}
+			if local.{{$col}} == key {
+			{{else -}}
+			if queries.Equal(local.{{$col}}, key) {
+			{{end -}}
+				local.R.counts[{{$ltable.UpSingular}}Rels.{{$relAlias.Local}}] = count
+			}
+		}
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on {{.ForeignTable}} counts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded counts for {{.ForeignTable}}")
+	}
+
 	return nil
 }
 
@@ -75,13 +75,13 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil
 	{{if $.NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(updateQuery, values))
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+		fmt.Fprintln(writer, boil.RedactArgs(updateQuery, values))
 	}
 	{{end -}}
 
@@ -95,6 +95,8 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil
 	}
 	{{- end}}
 
+	{{if $.NoContext}}boil.InvalidateCache("{{$.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{$.Table.Name}}"){{end}}
+
 	{{if $usesPrimitives -}}
 	o.{{$col}} = related.{{$fcol}}
 	{{else -}}
@@ -81,13 +81,13 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 		{{if $.NoContext -}}
 		if boil.DebugMode {
 			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
+			fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(updateQuery, values))
 		}
 		{{else -}}
 		if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
+			fmt.Fprintln(writer, boil.RedactArgs(updateQuery, values))
 		}
 		{{end -}}
 
@@ -99,6 +99,8 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 			return errors.Wrap(err, "failed to update foreign table")
 		}
 
+		{{if $.NoContext}}boil.InvalidateCache("{{.ForeignTable}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.ForeignTable}}"){{end}}
+
 		{{if $usesPrimitives -}}
 		related.{{$fcol}} = o.{{$col}}
 		{{- else -}}
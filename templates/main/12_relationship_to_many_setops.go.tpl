@@ -88,13 +88,13 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 			{{if $.NoContext -}}
 			if boil.DebugMode {
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
+				fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(updateQuery, values))
 			}
 			{{else -}}
 			if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+				fmt.Fprintln(writer, boil.RedactArgs(updateQuery, values))
 			}
 			{{end -}}
 
@@ -106,6 +106,8 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
+			{{if $.NoContext}}boil.InvalidateCache("{{.ForeignTable}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.ForeignTable}}"){{end}}
+
 			{{if $usesPrimitives -}}
 			rel.{{$fcol}} = o.{{$col}}
 			{{else -}}
@@ -122,13 +124,13 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 		{{if $.NoContext -}}
 		if boil.DebugMode {
 			fmt.Fprintln(boil.DebugWriter, query)
-			fmt.Fprintln(boil.DebugWriter, values)
+			fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(query, values))
 		}
 		{{else -}}
 		if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
+			fmt.Fprintln(writer, boil.RedactArgs(query, values))
 		}
 		{{end -}}
 
@@ -141,6 +143,8 @@ func (o *{{$ltable.UpSingular}}) Add{{$relAlias.Local}}({{if $.NoContext}}exec b
 			return errors.Wrap(err, "failed to insert into join table")
 		}
 	}
+
+	{{if $.NoContext}}boil.InvalidateCache("{{.JoinTable}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.JoinTable}}"){{end}}
 	{{end -}}
 
 	if o.R == nil {
@@ -250,13 +254,13 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 	{{if $.NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
-		fmt.Fprintln(boil.DebugWriter, values)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(query, values))
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
+		fmt.Fprintln(writer, boil.RedactArgs(query, values))
 	}
 	{{end -}}
 
@@ -269,6 +273,8 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
+	{{if $.NoContext}}boil.InvalidateCache("{{if .ToJoinTable}}{{.JoinTable}}{{else}}{{.ForeignTable}}{{end}}"){{else}}boil.InvalidateCacheContext(ctx, "{{if .ToJoinTable}}{{.JoinTable}}{{else}}{{.ForeignTable}}{{end}}"){{end}}
+
 	{{if and .ToJoinTable (not $.NoBackReferencing) -}}
 	remove{{$relAlias.Local}}From{{$relAlias.Foreign}}Slice(o, related)
 	if o.R != nil {
@@ -361,13 +367,13 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 	{{if $.NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
-		fmt.Fprintln(boil.DebugWriter, values)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(query, values))
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
+		fmt.Fprintln(writer, boil.RedactArgs(query, values))
 	}
 	{{end -}}
 
@@ -379,6 +385,8 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 	if err != nil {
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
+
+	{{if $.NoContext}}boil.InvalidateCache("{{.JoinTable}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.JoinTable}}"){{end}}
 	{{else -}}
 	for _, rel := range related {
 		queries.SetScanner(&rel.{{$fcol}}, nil)
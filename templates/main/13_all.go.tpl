@@ -1,18 +1,22 @@
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{- $canSoftDelete := .Table.CanSoftDelete $.AutoColumns.Deleted }}
+{{- $hasTenant := .Table.HasTenant $.AutoColumns.Tenant }}
 // {{$alias.UpPlural}} retrieves all the records using an executor.
 func {{$alias.UpPlural}}(mods ...qm.QueryMod) {{$alias.DownSingular}}Query {
-    {{if and .AddSoftDeletes $canSoftDelete -}}
-    mods = append(mods, qm.From("{{$schemaTable}}"), qmhelper.WhereIsNull("{{$schemaTable}}.{{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}}"))
-    {{else -}}
     mods = append(mods, qm.From("{{$schemaTable}}"))
-    {{end -}}
 
     q := NewQuery(mods...)
     if len(queries.GetSelect(q)) == 0 {
         queries.SetSelect(q, []string{"{{$schemaTable}}.*"})
     }
+    {{- if and .AddSoftDeletes $canSoftDelete}}
+    queries.SetSoftDeleteColumn(q, "{{$schemaTable}}.{{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}}")
+    {{- end}}
+    {{- if $hasTenant}}
+    queries.SetTenantColumn(q, "{{$schemaTable}}.{{$.AutoColumns.Tenant | $.Quotes}}")
+    {{- end}}
+    queries.AppendCacheTags(q, "{{.Table.Name}}")
 
     return {{$alias.DownSingular}}Query{q}
 }
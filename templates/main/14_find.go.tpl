@@ -5,6 +5,7 @@
 {{- $pkNames := $colDefs.Names | stringMap (aliasCols $alias) | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}
 {{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", " -}}
 {{- $canSoftDelete := .Table.CanSoftDelete $.AutoColumns.Deleted }}
+{{- $hasTenant := .Table.HasTenant $.AutoColumns.Tenant }}
 {{if .AddGlobal -}}
 // Find{{$alias.UpSingular}}G retrieves a single record by ID.
 func Find{{$alias.UpSingular}}G({{if not .NoContext}}ctx context.Context, {{end -}} {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
@@ -41,7 +42,19 @@ func Find{{$alias.UpSingular}}GP({{if not .NoContext}}ctx context.Context, {{end
 
 // Find{{$alias.UpSingular}} retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
+{{- if not .NoContext}} The record is cached when
+// the context is made with boil.WithCache.
+{{- end}}
 func Find{{$alias.UpSingular}}({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
+	{{if $hasTenant -}}
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{$.AutoColumns.Tenant}}", {{add (len .Table.PKey.Columns) 1}})
+	if err != nil {
+		return nil, err
+	}
+	{{end -}}
 	{{$alias.DownSingular}}Obj := &{{$alias.UpSingular}}{}
 
 	sel := "*"
@@ -50,11 +63,12 @@ func Find{{$alias.UpSingular}}({{if .NoContext}}exec boil.Executor{{else}}ctx co
 	}
 	query := fmt.Sprintf(
 		"select %s from {{.Table.Name | .SchemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}} is null{{end}}", sel,
-	)
+	){{if $hasTenant}} + tenantWhere{{end}}
 
-	q := queries.Raw(query, {{$pkNames | join ", "}})
+	q := queries.Raw(query, {{if $hasTenant}}append([]interface{}{{"{"}}{{$pkNames | join ", "}}{{"}"}}, tenantArgs...)...{{else}}{{$pkNames | join ", "}}{{end}})
+	queries.AppendCacheTags(q, "{{.Table.Name}}")
 
-	err := q.Bind({{if not .NoContext}}ctx{{else}}nil{{end}}, exec, {{$alias.DownSingular}}Obj)
+	err {{if not $hasTenant}}:{{end}}= q.Bind({{if not .NoContext}}ctx{{else}}nil{{end}}, exec, {{$alias.DownSingular}}Obj)
 	if err != nil {
 		{{if not .AlwaysWrapErrors -}}
 		if errors.Is(err, sql.ErrNoRows) {
@@ -1,6 +1,7 @@
 {{- if or (not .Table.IsView) (.Table.ViewCapabilities.CanInsert) -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $hasTenant := .Table.HasTenant .AutoColumns.Tenant}}
 {{if .AddGlobal -}}
 // InsertG a single record. See Insert for whitelist behavior description.
 func (o *{{$alias.UpSingular}}) InsertG({{if not .NoContext}}ctx context.Context, {{end -}} columns boil.Columns) error {
@@ -34,20 +35,23 @@ func (o *{{$alias.UpSingular}}) InsertGP({{if not .NoContext}}ctx context.Contex
 // Insert a single record using an executor.
 // See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
 func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.InsertOperation)
+	{{end -}}
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for insertion")
 	}
 
 	var err error
-	{{- template "timestamp_insert_helper" . }}
-
-	{{if not .NoHooks -}}
-	if err := o.doBeforeInsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+	if err := o.beforeInsert({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
 		return err
 	}
-	{{- end}}
 
+	{{if .Table.IsView -}}
 	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
+	{{- else -}}
+	nzDefaults := o.insertDefaults()
+	{{- end}}
 
 	key := makeCacheKey(columns, nzDefaults)
 	{{$alias.DownSingular}}InsertCacheMut.RLock()
@@ -98,6 +102,7 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 		}
 
 		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+		cache.columns = wl
 	}
 
 	value := reflect.Indirect(reflect.ValueOf(o))
@@ -106,13 +111,13 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, vals)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", cache.columns, vals))
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
+		fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", cache.columns, vals))
 	}
 	{{end -}}
 
@@ -122,19 +127,21 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 		{{if .NoContext -}}
 	result, err := exec.Exec(cache.query, vals...)
 		{{else -}}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
+	result, err := exec.ExecContext(boil.WithArgColumns(ctx, cache.columns), cache.query, vals...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
 	_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, vals...)
+	_, err = exec.ExecContext(boil.WithArgColumns(ctx, cache.columns), cache.query, vals...)
 		{{end -}}
 	{{- end}}
 	if err != nil {
 		return errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}}")
 	}
 
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
 	{{if $canLastInsertID -}}
 	var lastID int64
 	{{- end}}
@@ -167,20 +174,20 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
-		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", {{$alias.DownSingular}}PrimaryKeyColumns, identifierCols)...)
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, cache.retQuery)
-		fmt.Fprintln(writer, identifierCols...)
+		fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", {{$alias.DownSingular}}PrimaryKeyColumns, identifierCols)...)
 	}
 	{{end -}}
 
 	{{if .NoContext -}}
-	err = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	err = boil.QueryRow(exec, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 	{{else -}}
-	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	err = boil.QueryRowContext(boil.WithArgColumns(ctx, {{$alias.DownSingular}}PrimaryKeyColumns), exec, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 	{{end -}}
 	if err != nil {
 		return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
@@ -188,21 +195,23 @@ func (o *{{$alias.UpSingular}}) Insert({{if .NoContext}}exec boil.Executor{{else
 	{{else}}
 	if len(cache.retMapping) != 0 {
 		{{if .NoContext -}}
-		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+		err = boil.QueryRow(exec, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 		{{else -}}
-		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+		err = boil.QueryRowContext(boil.WithArgColumns(ctx, cache.columns), exec, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 		{{end -}}
 	} else {
 		{{if .NoContext -}}
 		_, err = exec.Exec(cache.query, vals...)
 		{{else -}}
-		_, err = exec.ExecContext(ctx, cache.query, vals...)
+		_, err = exec.ExecContext(boil.WithArgColumns(ctx, cache.columns), cache.query, vals...)
 		{{end -}}
 	}
 
 	if err != nil {
 		return errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}}")
 	}
+
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
 	{{end}}
 
 {{if .Dialect.UseLastInsertID -}}
@@ -214,6 +223,10 @@ CacheNoHooks:
 		{{$alias.DownSingular}}InsertCacheMut.Unlock()
 	}
 
+	{{if not .Table.IsView -}}
+	o.refreshSnapshot()
+
+	{{end -}}
 	{{if not .NoHooks -}}
 	return o.doAfterInsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
 	{{- else -}}
@@ -221,4 +234,422 @@ CacheNoHooks:
 	{{- end}}
 }
 
+{{if .AddGlobal -}}
+// InsertAllG inserts all rows in the slice using the global executor. See
+// InsertAll for batching and whitelist behavior.
+func (o {{$alias.UpSingular}}Slice) InsertAllG({{if not .NoContext}}ctx context.Context, {{end -}} columns boil.Columns) error {
+	return o.InsertAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, columns)
+}
+
+{{end -}}
+
+{{if and .AddGlobal .AddPanic -}}
+// InsertAllGP inserts all rows in the slice using the global executor, and
+// panics on error. See InsertAll for batching and whitelist behavior.
+func (o {{$alias.UpSingular}}Slice) InsertAllGP({{if not .NoContext}}ctx context.Context, {{end -}} columns boil.Columns) {
+	if err := o.InsertAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// InsertAllP inserts all rows in the slice using an executor, and panics on
+// error. See InsertAll for batching and whitelist behavior.
+func (o {{$alias.UpSingular}}Slice) InsertAllP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {
+	if err := o.InsertAll({{if not .NoContext}}ctx, {{end -}} exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// InsertAll inserts all rows in the slice using multi-row insert statements,
+// each holding as many rows as the database's parameter limit allows.
+// Rows are grouped by which of their columns with defaults are set, so that
+// the columns inserted for each row are the same as Insert would use.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+// The insert hooks are run for every row.
+{{- if .Dialect.UseLastInsertID}}
+//
+// Only an auto increment primary key is populated after the insert, other
+// columns with defaults are not, use ReloadAll to fetch them. The keys
+// generated by a multi-row insert are derived from the first one, see
+// InsertAllRowByRow.
+{{- end}}
+func (o {{$alias.UpSingular}}Slice) InsertAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.InsertOperation)
+	{{end -}}
+	if len(o) == 0 {
+		return nil
+	}
+
+	type insertGroup struct {
+		nzDefaults []string
+		rows       {{$alias.UpSingular}}Slice
+	}
+
+	var groups []*insertGroup
+	groupsByKey := make(map[string]*insertGroup)
+	for _, row := range o {
+		if row == nil {
+			return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for insertion")
+		}
+
+		if err := row.beforeInsert({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+
+		{{if .Table.IsView -}}
+		nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, row)
+		{{- else -}}
+		nzDefaults := row.insertDefaults()
+		{{- end}}
+		key := makeCacheKey(columns, nzDefaults)
+		group, ok := groupsByKey[key]
+		if !ok {
+			group = &insertGroup{nzDefaults: nzDefaults}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+		group.rows = append(group.rows, row)
+	}
+
+	// The chunks inserted before one that fails stay inserted
+	defer {{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if and .Dialect.UseLastInsertID .Table.CanLastInsertID -}}
+	// idIncrement is how far apart the ids generated by a statement are,
+	// it's read once it's needed
+	var idIncrement int64
+
+	{{end -}}
+	for _, group := range groups {
+		wl, {{if and .Dialect.UseLastInsertID (not .Table.CanLastInsertID)}}_{{else}}returnColumns{{end}} := columns.InsertColumnSet(
+			{{$alias.DownSingular}}AllColumns,
+			{{$alias.DownSingular}}ColumnsWithDefault,
+			{{$alias.DownSingular}}ColumnsWithoutDefault,
+			group.nzDefaults,
+		)
+		{{- if filterColumnsByAuto true .Table.Columns }}
+		wl = strmangle.SetComplement(wl, {{$alias.DownSingular}}GeneratedColumns)
+		{{- end}}
+
+		valueMapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, wl)
+		if err != nil {
+			return err
+		}
+		{{- if not .Dialect.UseLastInsertID}}
+		retMapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		{{- end}}
+
+		var queryOutput, queryReturning string
+		{{if .Dialect.UseOutputClause -}}
+		if len(retMapping) != 0 {
+			queryOutput = fmt.Sprintf("OUTPUT INSERTED.{{.LQ}}%s{{.RQ}} ", strings.Join(returnColumns, "{{.RQ}},INSERTED.{{.LQ}}"))
+		}
+		{{else if not .Dialect.UseLastInsertID -}}
+		// The returned rows are matched to the inserted ones by their primary
+		// key when it's inserted, otherwise by their order
+		var keyMapping []uint64
+		if len(retMapping) != 0 {
+			returning := returnColumns
+			if len(strmangle.SetIntersect(returnColumns, {{$alias.DownSingular}}PrimaryKeyColumns)) == 0 {
+				keyMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, {{$alias.DownSingular}}PrimaryKeyColumns)
+				if err != nil {
+					return err
+				}
+				returning = append(append([]string{}, {{$alias.DownSingular}}PrimaryKeyColumns...), returnColumns...)
+			}
+			queryReturning = fmt.Sprintf(" RETURNING {{.LQ}}%s{{.RQ}}", strings.Join(returning, "{{.RQ}},{{.LQ}}"))
+		}
+		{{end -}}
+
+		rowsPerQuery := 1
+		if len(wl) != 0 {
+			rowsPerQuery = maxQueryParams / len(wl)
+			if rowsPerQuery > maxInsertRows {
+				rowsPerQuery = maxInsertRows
+			}
+		}
+		{{- if and .Dialect.UseLastInsertID .Table.CanLastInsertID}}
+		{{- $colName := index .Table.PKey.Columns 0}}
+		generatedIDs := strmangle.SetInclude("{{$colName}}", returnColumns)
+		if generatedIDs && InsertAllRowByRow {
+			rowsPerQuery = 1
+		}
+		if generatedIDs && rowsPerQuery > 1 && len(group.rows) > 1 && idIncrement == 0 {
+			{{if .NoContext -}}
+			err = boil.QueryRow(exec, "SELECT @@auto_increment_increment").Scan(&idIncrement)
+			{{else -}}
+			err = boil.QueryRowContext(ctx, exec, "SELECT @@auto_increment_increment").Scan(&idIncrement)
+			{{end -}}
+			if err != nil {
+				return errors.Wrap(err, "{{.PkgName}}: unable to read the auto increment step for {{.Table.Name}}")
+			}
+		}
+		{{- end}}
+
+		for start := 0; start < len(group.rows); start += rowsPerQuery {
+			end := start + rowsPerQuery
+			if end > len(group.rows) {
+				end = len(group.rows)
+			}
+			chunk := group.rows[start:end]
+
+			var query string
+			var vals []interface{}
+			if len(wl) != 0 {
+				buf := strmangle.GetBuffer()
+				{{if .Dialect.UseOutputClause -}}
+				if len(retMapping) != 0 {
+					// The rows output by an insert are in no particular order,
+					// a merge can output the position of each row in the chunk
+					buf.WriteString("MERGE INTO {{$schemaTable}} USING (VALUES ")
+					for i, row := range chunk {
+						if i != 0 {
+							buf.WriteByte(',')
+						}
+						fmt.Fprintf(buf, "(%d,%s)", i, strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, 1))
+						vals = append(vals, queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(row)), valueMapping)...)
+					}
+					fmt.Fprintf(buf, ") AS {{.LQ}}s{{.RQ}} ({{.LQ}}boil_row{{.RQ}},{{.LQ}}%s{{.RQ}}) ON 1 = 0 WHEN NOT MATCHED THEN INSERT ({{.LQ}}%s{{.RQ}}) VALUES ({{.LQ}}s{{.RQ}}.{{.LQ}}%s{{.RQ}}) OUTPUT {{.LQ}}s{{.RQ}}.{{.LQ}}boil_row{{.RQ}},INSERTED.{{.LQ}}%s{{.RQ}};",
+						strings.Join(wl, "{{.RQ}},{{.LQ}}"),
+						strings.Join(wl, "{{.RQ}},{{.LQ}}"),
+						strings.Join(wl, "{{.RQ}},{{.LQ}}s{{.RQ}}.{{.LQ}}"),
+						strings.Join(returnColumns, "{{.RQ}},INSERTED.{{.LQ}}"),
+					)
+				} else {
+					fmt.Fprintf(buf, "INSERT INTO {{$schemaTable}} ({{.LQ}}%s{{.RQ}}) VALUES ", strings.Join(wl, "{{.RQ}},{{.LQ}}"))
+					for i, row := range chunk {
+						if i != 0 {
+							buf.WriteByte(',')
+						}
+						fmt.Fprintf(buf, "(%s)", strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, 1))
+						vals = append(vals, queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(row)), valueMapping)...)
+					}
+				}
+				{{- else -}}
+				fmt.Fprintf(buf, "INSERT INTO {{$schemaTable}} ({{.LQ}}%s{{.RQ}}) VALUES ", strings.Join(wl, "{{.RQ}},{{.LQ}}"))
+				for i, row := range chunk {
+					if i != 0 {
+						buf.WriteByte(',')
+					}
+					fmt.Fprintf(buf, "(%s)", strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, 1))
+					vals = append(vals, queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(row)), valueMapping)...)
+				}
+				buf.WriteString(queryReturning)
+				{{- end}}
+				query = buf.String()
+				strmangle.PutBuffer(buf)
+			} else {
+				{{if .Dialect.UseDefaultKeyword -}}
+				query = fmt.Sprintf("INSERT INTO {{$schemaTable}} %sDEFAULT VALUES%s", queryOutput, queryReturning)
+				{{else -}}
+				query = fmt.Sprintf("INSERT INTO {{$schemaTable}} () VALUES ()%s%s", queryOutput, queryReturning)
+				{{end -}}
+			}
+
+			{{if .NoContext -}}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, query)
+				fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", wl, vals))
+			}
+			{{else -}}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, query)
+				fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", wl, vals))
+			}
+			{{end -}}
+
+			{{if .Dialect.UseLastInsertID -}}
+			{{- $canLastInsertID := .Table.CanLastInsertID -}}
+			{{if $canLastInsertID -}}
+			{{if .NoContext -}}
+			result, err := exec.Exec(query, vals...)
+			{{else -}}
+			result, err := exec.ExecContext(boil.WithArgColumns(ctx, wl), query, vals...)
+			{{end -}}
+			{{else -}}
+			{{if .NoContext -}}
+			_, err = exec.Exec(query, vals...)
+			{{else -}}
+			_, err = exec.ExecContext(boil.WithArgColumns(ctx, wl), query, vals...)
+			{{end -}}
+			{{end -}}
+			if err != nil {
+				return errors.Wrap(err, "{{.PkgName}}: unable to insert all into {{.Table.Name}}")
+			}
+
+			{{if $canLastInsertID -}}
+			{{$colName := index .Table.PKey.Columns 0 -}}
+			{{- $col := .Table.GetColumn $colName -}}
+			if generatedIDs {
+				lastID, err := result.LastInsertId()
+				if err != nil {
+					return ErrSyncFail
+				}
+
+				// The id of the first row is returned, the ids of the others
+				// follow it
+				for i, row := range chunk {
+					row.{{$alias.Column $colName}} = {{$col.Type}}(lastID + int64(i)*idIncrement)
+				}
+			}
+			{{- end}}
+			{{else -}}
+			if len(retMapping) == 0 {
+				{{if .NoContext -}}
+				_, err = exec.Exec(query, vals...)
+				{{else -}}
+				_, err = exec.ExecContext(boil.WithArgColumns(ctx, wl), query, vals...)
+				{{end -}}
+				if err != nil {
+					return errors.Wrap(err, "{{.PkgName}}: unable to insert all into {{.Table.Name}}")
+				}
+				continue
+			}
+
+			{{if .NoContext -}}
+			rows, err := exec.Query(query, vals...)
+			{{else -}}
+			rows, err := exec.QueryContext(boil.WithArgColumns(ctx, wl), query, vals...)
+			{{end -}}
+			if err != nil {
+				return errors.Wrap(err, "{{.PkgName}}: unable to insert all into {{.Table.Name}}")
+			}
+
+			{{if .Dialect.UseOutputClause -}}
+			matched, err := scan{{$alias.UpSingular}}InsertAllRows(rows, chunk, len(wl) != 0, nil, retMapping)
+			{{- else -}}
+			matched, err := scan{{$alias.UpSingular}}InsertAllRows(rows, chunk, false, keyMapping, retMapping)
+			{{- end}}
+			if cerr := rows.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return errors.Wrap(err, "{{.PkgName}}: unable to populate default values for {{.Table.Name}}")
+			}
+			if matched != len(chunk) {
+				return ErrSyncFail
+			}
+			{{end -}}
+		}
+	}
+
+	{{if not .Table.IsView -}}
+	for _, row := range o {
+		row.refreshSnapshot()
+	}
+
+	{{end -}}
+	{{if not .NoHooks -}}
+	for _, row := range o {
+		if err := row.doAfterInsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+			return err
+		}
+	}
+
+	{{end -}}
+	return nil
+}
+
+// beforeInsert sets the automatic columns of a row that's about to be
+// inserted and runs its before insert hooks.
+func (o *{{$alias.UpSingular}}) beforeInsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	{{- template "timestamp_insert_helper" . }}
+	{{- if $hasTenant}}
+	if err := o.setTenant(ctx); err != nil {
+		return err
+	}
+	{{- end}}
+
+	{{if not .NoHooks -}}
+	return o.doBeforeInsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
+	{{- else -}}
+	return nil
+	{{- end}}
+}
+{{- if not .Dialect.UseLastInsertID}}
+
+// scan{{$alias.UpSingular}}InsertAllRows scans the columns returned by a
+// multi-row insert into the inserted rows and returns how many were matched.
+// Each returned row is matched by its position in chunk when it starts with
+// it, by its primary key when there's a keyMapping, otherwise by its order.
+func scan{{$alias.UpSingular}}InsertAllRows(rows *sql.Rows, chunk {{$alias.UpSingular}}Slice, positioned bool, keyMapping, retMapping []uint64) (int, error) {
+	var byKey map[string]*{{$alias.UpSingular}}
+	if len(keyMapping) != 0 {
+		byKey = make(map[string]*{{$alias.UpSingular}}, len(chunk))
+		for _, row := range chunk {
+			byKey[fmt.Sprint(queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(row)), keyMapping))] = row
+		}
+	}
+
+	matched := 0
+	for rows.Next() {
+		var returned {{$alias.UpSingular}}
+		value := reflect.ValueOf(&returned).Elem()
+		retPtrs := queries.PtrsFromMapping(value, retMapping)
+
+		var position int
+		var dest []interface{}
+		if positioned {
+			dest = append(dest, &position)
+		}
+		dest = append(dest, queries.PtrsFromMapping(value, keyMapping)...)
+		dest = append(dest, retPtrs...)
+		if err := rows.Scan(dest...); err != nil {
+			return matched, err
+		}
+
+		var row *{{$alias.UpSingular}}
+		switch {
+		case positioned:
+			if position >= 0 && position < len(chunk) {
+				row = chunk[position]
+			}
+		case byKey != nil:
+			row = byKey[fmt.Sprint(queries.ValuesFromMapping(value, keyMapping))]
+		case matched < len(chunk):
+			row = chunk[matched]
+		}
+		if row == nil {
+			return matched, ErrSyncFail
+		}
+
+		rowPtrs := queries.PtrsFromMapping(reflect.Indirect(reflect.ValueOf(row)), retMapping)
+		for i := range rowPtrs {
+			reflect.ValueOf(rowPtrs[i]).Elem().Set(reflect.ValueOf(retPtrs[i]).Elem())
+		}
+		matched++
+	}
+
+	return matched, rows.Err()
+}
+{{- end}}
+
+{{- if $hasTenant}}
+
+// setTenant sets the tenant of the row to the tenant in the context, the
+// rows written with it always belong to it. The row keeps its tenant when
+// the context has none and was made for all tenants.
+func (o *{{$alias.UpSingular}}) setTenant(ctx context.Context) error {
+	tenant, ok := boil.TenantFrom(ctx)
+	if !ok {
+		if boil.IsAllTenants(ctx) {
+			return nil
+		}
+		return boil.ErrNoTenant
+	}
+
+	return queries.AssignTenant(&o.{{$alias.Column .AutoColumns.Tenant}}, tenant)
+}
+{{- end}}
+
 {{- end -}}
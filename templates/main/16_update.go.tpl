@@ -2,6 +2,12 @@
 {{- else -}}
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $versionCol := .AutoColumns.Version}}
+{{- $versioned := .Table.HasVersion $versionCol}}
+{{- $hasTenant := .Table.HasTenant .AutoColumns.Tenant}}
+{{- $query := "cache.query"}}
+{{- $columns := "cache.columns"}}
+{{- if $hasTenant}}{{$query = "query"}}{{$columns = "argColumns"}}{{end}}
 {{if .AddGlobal -}}
 // UpdateG a single {{$alias.UpSingular}} record using the global executor.
 // See Update for more documentation.
@@ -45,8 +51,33 @@ func (o *{{$alias.UpSingular}}) UpdateGP({{if not .NoContext}}ctx context.Contex
 
 // Update uses an executor to update the {{$alias.UpSingular}}.
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// With boil.Dirty only the columns changed since the object was loaded are updated, nothing is
+// done when there are none. Objects without a snapshot don't know what changed, so they're
+// updated like with boil.Infer instead.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+{{- if $versioned}}
+// The update only matches the row when its {{$versionCol}} is unchanged, which is
+// then incremented. ErrStaleObject is returned when someone else changed the row first.
+{{- end}}
+{{- if $hasTenant}}
+// The row is only matched when it belongs to the tenant in the context, the
+// object is given the tenant like inserted ones.
+{{- end}}
 func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpdateOperation)
+	{{end -}}
+	{{- if $hasTenant}}
+	if err := o.setTenant(ctx); err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	{{- end}}
+	if columns.IsDirty() && (o.tracking == nil || o.tracking.loaded == nil) {
+		columns = boil.Infer()
+	}
+	if columns.IsDirty() && len(o.Changes()) == 0 {
+		return {{if not .NoRowsAffected}}0, {{end -}} nil
+	}
 	{{- template "timestamp_update_helper" . -}}
 
 	var err error
@@ -56,6 +87,10 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 	}
 	{{end -}}
 
+	if columns.IsDirty() {
+		columns = boil.Whitelist(strmangle.SetComplement(o.dirtyColumns(), {{$alias.DownSingular}}PrimaryKeyColumns)...)
+	}
+
 	key := makeCacheKey(columns, nil)
 	{{$alias.DownSingular}}UpdateCacheMut.RLock()
 	cache, cached := {{$alias.DownSingular}}UpdateCache[key]
@@ -77,54 +112,91 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 		if len(wl) == 0 {
 			return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: unable to update {{.Table.Name}}, could not build whitelist")
 		}
+		{{- if $versioned}}
+		wl = strmangle.SetMerge(wl, []string{"{{$versionCol}}"})
+		{{- end}}
 
-		cache.query = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s",
+		cache.query = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s{{if $versioned}} AND {{.LQ}}{{$versionCol}}{{.RQ}} = %s{{end}}",
 			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
 			strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}len(wl)+1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns),
+			{{- if $versioned}}
+			{{if .Dialect.UseIndexPlaceholders}}fmt.Sprintf("$%d", len(wl)+len({{$alias.DownSingular}}PrimaryKeyColumns)+1){{else}}"?"{{end}},
+			{{- end}}
 		)
 		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, append(wl, {{$alias.DownSingular}}PrimaryKeyColumns...))
 		if err != nil {
 			return {{if not .NoRowsAffected}}0, {{end -}} err
 		}
+		cache.columns = append(append([]string{}, wl...), {{$alias.DownSingular}}PrimaryKeyColumns...)
+		{{- if $versioned}}
+		cache.columns = append(cache.columns, "{{$versionCol}}")
+		{{- end}}
+	}
+
+	{{if $hasTenant -}}
+	query, argColumns := cache.query, cache.columns
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{.AutoColumns.Tenant}}", len(argColumns)+1)
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	if len(tenantArgs) != 0 {
+		query += tenantWhere
+		argColumns = append(argColumns[:len(argColumns):len(argColumns)], "{{.AutoColumns.Tenant}}")
 	}
 
+	{{end -}}
+	{{if $versioned -}}
+	version := o.{{$alias.Column $versionCol}}
+	o.{{$alias.Column $versionCol}}++
+	{{end -}}
 	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+	{{- if $versioned}}
+	values = append(values, version)
+	{{- end}}
+	{{- if $hasTenant}}
+	values = append(values, tenantArgs...)
+	{{- end}}
 
 	{{if .NoContext -}}
 	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, values)
+		fmt.Fprintln(boil.DebugWriter, {{$query}})
+		fmt.Fprintln(boil.DebugWriter, boil.RedactColumnArgs("{{.Table.Name}}", {{$columns}}, values))
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, values)
+		fmt.Fprintln(writer, {{$query}})
+		fmt.Fprintln(writer, boil.RedactColumnArgs("{{.Table.Name}}", {{$columns}}, values))
 	}
 	{{end -}}
 
-	{{if .NoRowsAffected -}}
+	{{if and .NoRowsAffected (not $versioned) -}}
 		{{if .NoContext -}}
-	_, err = exec.Exec(cache.query, values...)
+	_, err = exec.Exec({{$query}}, values...)
 		{{else -}}
-	_, err = exec.ExecContext(ctx, cache.query, values...)
+	_, err = exec.ExecContext(boil.WithArgColumns(ctx, {{$columns}}), {{$query}}, values...)
 		{{end -}}
 	{{else -}}
 	var result sql.Result
 		{{if .NoContext -}}
-	result, err = exec.Exec(cache.query, values...)
+	result, err = exec.Exec({{$query}}, values...)
 		{{else -}}
-	result, err = exec.ExecContext(ctx, cache.query, values...)
+	result, err = exec.ExecContext(boil.WithArgColumns(ctx, {{$columns}}), {{$query}}, values...)
 		{{end -}}
 	{{end -}}
 	if err != nil {
+		{{- if $versioned}}
+		o.{{$alias.Column $versionCol}} = version
+		{{- end}}
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to update {{.Table.Name}} row")
 	}
 
-	{{if not .NoRowsAffected -}}
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if or (not .NoRowsAffected) $versioned -}}
 	rowsAff, err := result.RowsAffected()
 	if err != nil {
-		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by update for {{.Table.Name}}")
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by update for {{.Table.Name}}")
 	}
 
 	{{end -}}
@@ -135,9 +207,22 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 		{{$alias.DownSingular}}UpdateCacheMut.Unlock()
 	}
 
+	{{if $versioned -}}
+	if rowsAff == 0 {
+		o.{{$alias.Column $versionCol}} = version
+		return {{if not .NoRowsAffected}}0, {{end -}} ErrStaleObject
+	}
+
+	{{end -}}
 	{{if not .NoHooks -}}
-	return {{if not .NoRowsAffected}}rowsAff, {{end -}} o.doAfterUpdateHooks({{if not .NoContext}}ctx, {{end -}} exec)
+	// The after update hooks still see the changes that were written
+	err = o.doAfterUpdateHooks({{if not .NoContext}}ctx, {{end -}} exec)
+	o.snapshotColumns(cache.valueMapping)
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} err
 	{{- else -}}
+	o.snapshotColumns(cache.valueMapping)
+
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 	{{- end}}
 }
@@ -184,7 +269,23 @@ func (q {{$alias.DownSingular}}Query) UpdateAllGP({{if not .NoContext}}ctx conte
 
 
 // UpdateAll updates all rows with the specified column values.
+{{- if $versioned}}
+// The {{$versionCol}} of the rows is neither checked nor incremented.
+{{- end}}
+{{- if .Audited .Table.Name}}
+// The rows aren't known so they can't be audited, it fails unless the hooks of
+// the context are skipped.
+{{- end}}
 func (q {{$alias.DownSingular}}Query) UpdateAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols M) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpdateOperation)
+	{{end -}}
+	{{if .Audited .Table.Name -}}
+	if !boil.HooksAreSkipped(ctx) {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: unable to update all for {{.Table.Name}}, the rows of a query aren't audited, update a slice of them instead")
+	}
+
+	{{end -}}
 	queries.SetUpdate(q.Query, cols)
 
 	{{if .NoRowsAffected -}}
@@ -204,6 +305,8 @@ func (q {{$alias.DownSingular}}Query) UpdateAll({{if .NoContext}}exec boil.Execu
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to update all for {{.Table.Name}}")
 	}
 
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
 	{{if not .NoRowsAffected -}}
 	rowsAff, err := result.RowsAffected()
 	if err != nil {
@@ -254,7 +357,21 @@ func (o {{$alias.UpSingular}}Slice) UpdateAllP({{if .NoContext}}exec boil.Execut
 {{end -}}
 
 // UpdateAll updates all rows with the specified column values, using an executor.
+{{- if $versioned}}
+// Each row is only matched when its {{$versionCol}} is unchanged, which is then
+// incremented. ErrStaleObject is returned when fewer rows than the slice holds
+// were updated, the ones that were are only rolled back when exec is a transaction.
+{{- end}}
+{{- if .Audited .Table.Name}}
+// Every row is audited with the values of cols as its new values.
+{{- end}}
+{{- if $hasTenant}}
+// Only the rows of the tenant in the context are updated.
+{{- end}}
 func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, cols M) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpdateOperation)
+	{{end -}}
 	ln := int64(len(o))
 	if ln == 0 {
 		return {{if not .NoRowsAffected}}0, {{end -}} nil
@@ -263,6 +380,12 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 	if len(cols) == 0 {
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: update all requires at least one column argument")
 	}
+	{{- if $versioned}}
+
+	if _, ok := cols["{{$versionCol}}"]; ok {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: update all can not set {{$versionCol}}, it is incremented automatically")
+	}
+	{{- end}}
 
 	colNames := make([]string, len(cols))
 	args := make([]interface{}, len(cols))
@@ -278,30 +401,41 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 	for _, obj := range o {
 		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$alias.DownSingular}}PrimaryKeyMapping)
 		args = append(args, pkeyArgs...)
+		{{- if $versioned}}
+		args = append(args, obj.{{$alias.Column $versionCol}})
+		{{- end}}
 	}
 
-	sql := fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s",
+	{{if $hasTenant -}}
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{.AutoColumns.Tenant}}", len(args)+1)
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	args = append(args, tenantArgs...)
+
+	{{end -}}
+	sql := fmt.Sprintf("UPDATE {{$schemaTable}} SET %s{{if $versioned}}, {{.LQ}}{{$versionCol}}{{.RQ}} = {{.LQ}}{{$versionCol}}{{.RQ}} + 1{{end}} WHERE {{if $hasTenant}}(%s)%s{{else}}%s{{end}}",
 		strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, colNames),
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}len(colNames)+1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}len(colNames)+1{{else}}0{{end}}, {{if $versioned}}[]string{{"{"}}{{.Table.PKey.Columns | stringMap .StringFuncs.quoteWrap | join ", "}}, "{{$versionCol}}"{{"}"}}{{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}, len(o)){{if $hasTenant}}, tenantWhere{{end}})
 
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args...)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(sql, args)...)
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args...)
+		fmt.Fprintln(writer, boil.RedactArgs(sql, args)...)
 	}
 	{{end -}}
 
-	{{if .NoRowsAffected -}}
+	{{if and .NoRowsAffected (not $versioned) -}}
 		{{if .NoContext -}}
-	_, err := exec.Exec(sql, args...)
+	_, err {{if not $hasTenant}}:{{end}}= exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err {{if not $hasTenant}}:{{end}}= exec.ExecContext(ctx, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
@@ -314,12 +448,38 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to update all in {{$alias.DownSingular}} slice")
 	}
 
-	{{if not .NoRowsAffected -}}
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if or (not .NoRowsAffected) $versioned -}}
 	rowsAff, err := result.RowsAffected()
 	if err != nil {
-		return 0, errors.Wrap(err, "{{.PkgName}}: unable to retrieve rows affected all in update all {{$alias.DownSingular}}")
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to retrieve rows affected all in update all {{$alias.DownSingular}}")
 	}
 	{{end -}}
+	{{- if $versioned}}
+
+	if rowsAff != ln {
+		return {{if not .NoRowsAffected}}rowsAff, {{end -}} ErrStaleObject
+	}
+	for _, obj := range o {
+		obj.{{$alias.Column $versionCol}}++
+	}
+	{{- end}}
+	{{- if .Audited .Table.Name}}
+
+	for _, obj := range o {
+		changes := make(map[string][2]interface{}, len(cols))
+		for name, value := range cols {
+			changes[name] = [2]interface{}{nil, value}
+		}
+		{{- if $versioned}}
+		changes["{{$versionCol}}"] = [2]interface{}{obj.{{$alias.Column $versionCol}} - 1, obj.{{$alias.Column $versionCol}}}
+		{{- end}}
+		if err := obj.auditUpdate(ctx, exec, changes); err != nil {
+			return {{if not .NoRowsAffected}}rowsAff, {{end -}} err
+		}
+	}
+	{{- end}}
 
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 }
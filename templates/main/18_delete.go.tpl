@@ -5,6 +5,20 @@
 {{- $canSoftDelete := .Table.CanSoftDelete $.AutoColumns.Deleted -}}
 {{- $soft := and .AddSoftDeletes $canSoftDelete }}
 {{- $softDelCol := or $.AutoColumns.Deleted "deleted_at"}}
+{{- $versionCol := .AutoColumns.Version}}
+{{- $versioned := .Table.HasVersion $versionCol}}
+{{- $hasTenant := .Table.HasTenant .AutoColumns.Tenant}}
+{{- $versionWhere := printf "%s%s%s = " .LQ $versionCol .RQ}}
+{{- $softWhereStart := 2}}
+{{- if $versioned}}{{$softWhereStart = 3}}{{end}}
+{{- $ctxArgs := "exec"}}
+{{- if not .NoContext}}{{$ctxArgs = "ctx, exec"}}{{end}}
+{{- $cascades := false}}
+{{- if $soft}}
+{{- range .Table.ToManyRelationships}}
+{{- if $.CascadesSoftDelete .}}{{$cascades = true}}{{end}}
+{{- end}}
+{{- end}}
 {{if .AddGlobal -}}
 // DeleteG deletes a single {{$alias.UpSingular}} record.
 // DeleteG will match against the primary key column to find the record to delete.
@@ -50,7 +64,17 @@ func (o *{{$alias.UpSingular}}) DeleteGP({{if not .NoContext}}ctx context.Contex
 
 // Delete deletes a single {{$alias.UpSingular}} record with an executor.
 // Delete will match against the primary key column to find the record to delete.
+{{- if $versioned}}
+// The row is only matched when its {{$versionCol}} is unchanged, ErrStaleObject
+// is returned when someone else changed or deleted it first.
+{{- end}}
+{{- if $hasTenant}}
+// The row is only matched when it belongs to the tenant in the context.
+{{- end}}
 func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.DeleteOperation)
+	{{end -}}
 	if o == nil {
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: no {{$alias.UpSingular}} provided for delete")
 	}
@@ -66,14 +90,27 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 		sql string
 		args []interface{}
 	)
+	deletedAt := o.{{$alias.Column $softDelCol}}
+	{{- if $versioned}}
+	version := o.{{$alias.Column $versionCol}}
+	{{- end}}
 	if hardDelete {
 		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$alias.DownSingular}}PrimaryKeyMapping)
-		sql = "DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}"
+		{{- if $versioned}}
+		args = append(args, version)
+		{{- end}}
+		sql = "DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}
+			{{- if $versioned}} AND {{$versionWhere}}{{if .Dialect.UseIndexPlaceholders}}${{add (len .Table.PKey.Columns) 1}}{{else}}?{{end}}{{end}}"
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
 		o.{{$alias.Column $softDelCol}} = null.TimeFrom(currTime)
 		wl := []string{"{{$softDelCol}}"}
-		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 2 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}",
+		{{- if $versioned}}
+		o.{{$alias.Column $versionCol}}++
+		wl = append(wl, "{{$versionCol}}")
+		{{- end}}
+		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ $softWhereStart .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}
+			{{- if $versioned}} AND {{$versionWhere}}{{if .Dialect.UseIndexPlaceholders}}${{add (len .Table.PKey.Columns) 3}}{{else}}?{{end}}{{end}}",
 			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
 		)
 		valueMapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, append(wl, {{$alias.DownSingular}}PrimaryKeyColumns...))
@@ -81,30 +118,52 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 			return {{if not .NoRowsAffected}}0, {{end -}} err
 		}
 		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+		{{- if $versioned}}
+		args = append(args, version)
+		{{- end}}
 	}
 	{{else -}}
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$alias.DownSingular}}PrimaryKeyMapping)
-	sql := "DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}"
+	{{- if $versioned}}
+	args = append(args, o.{{$alias.Column $versionCol}})
 	{{- end}}
+	sql := "DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}
+		{{- if $versioned}} AND {{$versionWhere}}{{if .Dialect.UseIndexPlaceholders}}${{add (len .Table.PKey.Columns) 1}}{{else}}?{{end}}{{end}}"
+	{{- end}}
+
+	{{if $hasTenant -}}
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{.AutoColumns.Tenant}}", len(args)+1)
+	if err != nil {
+		{{- if $soft}}
+		o.{{$alias.Column $softDelCol}} = deletedAt
+		{{- if $versioned}}
+		o.{{$alias.Column $versionCol}} = version
+		{{- end}}
+		{{- end}}
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	sql += tenantWhere
+	args = append(args, tenantArgs...)
 
+	{{end -}}
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args...)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(sql, args)...)
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args...)
+		fmt.Fprintln(writer, boil.RedactArgs(sql, args)...)
 	}
 	{{end -}}
 
-	{{if .NoRowsAffected -}}
+	{{if and .NoRowsAffected (not $versioned) -}}
 		{{if .NoContext -}}
-	_, err := exec.Exec(sql, args...)
+	_, err {{if not $hasTenant}}:{{end}}= exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err {{if not $hasTenant}}:{{end}}= exec.ExecContext(ctx, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
@@ -114,13 +173,48 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 		{{end -}}
 	{{end -}}
 	if err != nil {
+		{{- if $soft}}
+		o.{{$alias.Column $softDelCol}} = deletedAt
+		{{- if $versioned}}
+		o.{{$alias.Column $versionCol}} = version
+		{{- end}}
+		{{- end}}
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to delete from {{.Table.Name}}")
 	}
 
-	{{if not .NoRowsAffected -}}
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if or (not .NoRowsAffected) $versioned -}}
 	rowsAff, err := result.RowsAffected()
 	if err != nil {
-		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by delete for {{.Table.Name}}")
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by delete for {{.Table.Name}}")
+	}
+
+	{{end -}}
+
+	{{if $versioned -}}
+	if rowsAff == 0 {
+		{{- if $soft}}
+		o.{{$alias.Column $softDelCol}} = deletedAt
+		o.{{$alias.Column $versionCol}} = version
+		{{- end}}
+		return {{if not .NoRowsAffected}}0, {{end -}} ErrStaleObject
+	}
+
+	{{end -}}
+
+	{{if $cascades -}}
+	if !hardDelete {
+		{{- range .Table.ToManyRelationships -}}
+		{{- if $.CascadesSoftDelete . -}}
+		{{- $ftable := $.Aliases.Table .ForeignTable}}
+		if err := softDelete{{$ftable.UpPlural}}Cascade({{$ctxArgs}}, o.{{$alias.Column $softDelCol}}.Time,
+			qm.Where("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}} = ?", o.{{$alias.Column .Column}}),
+		); err != nil {
+			return {{if not $.NoRowsAffected}}0, {{end -}} err
+		}
+		{{- end -}}
+		{{- end}}
 	}
 
 	{{end -}}
@@ -172,16 +266,42 @@ func (q {{$alias.DownSingular}}Query) DeleteAllGP({{if not .NoContext}}ctx conte
 {{end -}}
 
 // DeleteAll deletes all matching rows.
+{{- if and $soft $versioned}}
+// The {{$versionCol}} of soft deleted rows is neither checked nor incremented.
+{{- end}}
+{{- if .Audited .Table.Name}}
+// The rows aren't known so they can't be audited, it fails unless the hooks of
+// the context are skipped.
+{{- end}}
 func (q {{$alias.DownSingular}}Query) DeleteAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.DeleteOperation)
+	{{end -}}
 	if q.Query == nil {
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: no {{$alias.DownSingular}}Query provided for delete all")
 	}
+	{{- if .Audited .Table.Name}}
+	if !boil.HooksAreSkipped(ctx) {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: unable to delete all from {{.Table.Name}}, the rows of a query aren't audited, delete a slice of them instead")
+	}
+	{{- end}}
 
 	{{if $soft -}}
 	if hardDelete {
 		queries.SetDelete(q.Query)
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
+		{{- range .Table.ToManyRelationships -}}
+		{{- if $.CascadesSoftDelete . -}}
+		{{- $ftable := $.Aliases.Table .ForeignTable}}
+		queries.SetSelect(q.Query, []string{"{{$schemaTable}}.{{.Column | $.Quotes}}"})
+		if err := softDelete{{$ftable.UpPlural}}Cascade({{$ctxArgs}}, currTime,
+			qm.WhereInQuery("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}}", q.Query),
+		); err != nil {
+			return {{if not $.NoRowsAffected}}0, {{end -}} err
+		}
+		{{- end -}}
+		{{- end}}
 		queries.SetUpdate(q.Query, M{"{{$softDelCol}}": currTime})
 	}
 	{{else -}}
@@ -205,6 +325,8 @@ func (q {{$alias.DownSingular}}Query) DeleteAll({{if .NoContext}}exec boil.Execu
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to delete all from {{.Table.Name}}")
 	}
 
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
 	{{if not .NoRowsAffected -}}
 	rowsAff, err := result.RowsAffected()
 	if err != nil {
@@ -255,7 +377,19 @@ func (o {{$alias.UpSingular}}Slice) DeleteAllGP({{if not .NoContext}}ctx context
 {{end -}}
 
 // DeleteAll deletes all rows in the slice, using an executor.
+{{- if and $soft $versioned}}
+// When soft deleting each row is only matched when its {{$versionCol}} is unchanged,
+// which is then incremented. ErrStaleObject is returned when fewer rows than the
+// slice holds were deleted, the ones that were are only rolled back when exec is
+// a transaction.
+{{- end}}
+{{- if $hasTenant}}
+// Only the rows of the tenant in the context are deleted.
+{{- end}}
 func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.DeleteOperation)
+	{{end -}}
 	if len(o) == 0 {
 		return {{if not .NoRowsAffected}}0, {{end -}} nil
 	}
@@ -275,23 +409,32 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 		sql string
 		args []interface{}
 	)
+	{{- if or $versioned $hasTenant}}
+	deletedAts := make([]null.Time, len(o))
+	{{- end}}
 	if hardDelete {
 		for _, obj := range o {
     		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$alias.DownSingular}}PrimaryKeyMapping)
     		args = append(args, pkeyArgs...)
     	}
-		sql = "DELETE FROM {{$schemaTable}} WHERE " +
-			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o))
+		sql = "DELETE FROM {{$schemaTable}} WHERE {{if $hasTenant}}({{end}}" +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)){{if $hasTenant}} + ")"{{end}}
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
-		for _, obj := range o {
+		for {{if or $versioned $hasTenant}}i{{else}}_{{end}}, obj := range o {
 			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$alias.DownSingular}}PrimaryKeyMapping)
 			args = append(args, pkeyArgs...)
+			{{- if $versioned}}
+			args = append(args, obj.{{$alias.Column $versionCol}})
+			{{- end}}
+			{{- if or $versioned $hasTenant}}
+			deletedAts[i] = obj.{{$alias.Column $softDelCol}}
+			{{- end}}
 			obj.{{$alias.Column $softDelCol}} = null.TimeFrom(currTime)
 		}
 		wl := []string{"{{$softDelCol}}"}
-		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE " +
-			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)),
+		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s{{if $versioned}}, {{.LQ}}{{$versionCol}}{{.RQ}} = {{.LQ}}{{$versionCol}}{{.RQ}} + 1{{end}} WHERE {{if $hasTenant}}({{end}}" +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{if $versioned}}[]string{{"{"}}{{.Table.PKey.Columns | stringMap .StringFuncs.quoteWrap | join ", "}}, "{{$versionCol}}"{{"}"}}{{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}, len(o)){{if $hasTenant}} + ")"{{end}},
 			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
 		)
 		args = append([]interface{}{currTime}, args...)
@@ -303,28 +446,44 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 		args = append(args, pkeyArgs...)
 	}
 
-	sql := "DELETE FROM {{$schemaTable}} WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o))
+	sql := "DELETE FROM {{$schemaTable}} WHERE {{if $hasTenant}}({{end}}" +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)){{if $hasTenant}} + ")"{{end}}
 	{{- end}}
 
+	{{if $hasTenant -}}
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{.AutoColumns.Tenant}}", len(args)+1)
+	if err != nil {
+		{{- if $soft}}
+		if !hardDelete {
+			for i, obj := range o {
+				obj.{{$alias.Column $softDelCol}} = deletedAts[i]
+			}
+		}
+		{{- end}}
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	sql += tenantWhere
+	args = append(args, tenantArgs...)
+
+	{{end -}}
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(sql, args))
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args)
+		fmt.Fprintln(writer, boil.RedactArgs(sql, args))
 	}
 	{{end -}}
 
-	{{if .NoRowsAffected -}}
+	{{if and .NoRowsAffected (not (and $soft $versioned)) -}}
 		{{if .NoContext -}}
-	_, err := exec.Exec(sql, args...)
+	_, err {{if not $hasTenant}}:{{end}}= exec.Exec(sql, args...)
 		{{else -}}
-	_, err := exec.ExecContext(ctx, sql, args...)
+	_, err {{if not $hasTenant}}:{{end}}= exec.ExecContext(ctx, sql, args...)
 		{{end -}}
 	{{else -}}
 		{{if .NoContext -}}
@@ -337,10 +496,47 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to delete all from {{$alias.DownSingular}} slice")
 	}
 
-	{{if not .NoRowsAffected -}}
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if or (not .NoRowsAffected) (and $soft $versioned) -}}
 	rowsAff, err := result.RowsAffected()
 	if err != nil {
-		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by deleteall for {{.Table.Name}}")
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by deleteall for {{.Table.Name}}")
+	}
+
+	{{end -}}
+
+	{{if and $soft $versioned -}}
+	if !hardDelete {
+		if rowsAff != int64(len(o)) {
+			for i, obj := range o {
+				obj.{{$alias.Column $softDelCol}} = deletedAts[i]
+			}
+			return {{if not .NoRowsAffected}}rowsAff, {{end -}} ErrStaleObject
+		}
+		for _, obj := range o {
+			obj.{{$alias.Column $versionCol}}++
+		}
+	}
+
+	{{end -}}
+
+	{{if $cascades -}}
+	if !hardDelete {
+		{{- range .Table.ToManyRelationships -}}
+		{{- if $.CascadesSoftDelete . -}}
+		{{- $ftable := $.Aliases.Table .ForeignTable}}
+		{{$alias.DownSingular}}{{$ftable.UpPlural}}Args := make([]interface{}, len(o))
+		for i, obj := range o {
+			{{$alias.DownSingular}}{{$ftable.UpPlural}}Args[i] = obj.{{$alias.Column .Column}}
+		}
+		if err := softDelete{{$ftable.UpPlural}}Cascade({{$ctxArgs}}, o[0].{{$alias.Column $softDelCol}}.Time,
+			qm.WhereIn("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}} IN ?", {{$alias.DownSingular}}{{$ftable.UpPlural}}Args...),
+		); err != nil {
+			return {{if not $.NoRowsAffected}}0, {{end -}} err
+		}
+		{{- end -}}
+		{{- end}}
 	}
 
 	{{end -}}
@@ -3,6 +3,7 @@
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $schemaTable := .Table.Name | .SchemaTable -}}
 {{- $canSoftDelete := .Table.CanSoftDelete $.AutoColumns.Deleted }}
+{{- $hasTenant := .Table.HasTenant $.AutoColumns.Tenant }}
 {{if .AddGlobal -}}
 // ReloadG refetches the object from the database using the primary keys.
 func (o *{{$alias.UpSingular}}) ReloadG({{if not .NoContext}}ctx context.Context{{end}}) error {
@@ -38,12 +39,19 @@ func (o *{{$alias.UpSingular}}) ReloadGP({{if not .NoContext}}ctx context.Contex
 // Reload refetches the object from the database
 // using the primary keys with an executor.
 func (o *{{$alias.UpSingular}}) Reload({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
 	ret, err := Find{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} exec, {{.Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", "}})
 	if err != nil {
 		return err
 	}
 
+	tracked := o.tracking != nil && o.tracking.loaded != nil
 	*o = *ret
+	if tracked {
+		o.Snapshot()
+	}
 	return nil
 }
 
@@ -87,6 +95,9 @@ func (o *{{$alias.UpSingular}}Slice) ReloadAllGP({{if not .NoContext}}ctx contex
 // ReloadAll refetches every row with matching primary key column values
 // and overwrites the original object slice with the newly updated slice.
 func (o *{{$alias.UpSingular}}Slice) ReloadAll({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
 	if o == nil || len(*o) == 0 {
 		return nil
 	}
@@ -102,10 +113,25 @@ func (o *{{$alias.UpSingular}}Slice) ReloadAll({{if .NoContext}}exec boil.Execut
 		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(*o)){{if and .AddSoftDeletes $canSoftDelete}} +
 		"and {{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}} is null"
 		{{- end}}
+	{{- if $hasTenant}}
+
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{$.AutoColumns.Tenant}}", len(args)+1)
+	if err != nil {
+		return err
+	}
+	sql += tenantWhere
+	args = append(args, tenantArgs...)
+	{{- end}}
 
 	q := queries.Raw(sql, args...)
+	for _, obj := range *o {
+		if obj.tracking != nil && obj.tracking.loaded != nil {
+			queries.SetTrackChanges(q)
+			break
+		}
+	}
 
-	err := q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &slice)
+	err {{if not $hasTenant}}:{{end}}= q.Bind({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &slice)
 	if err != nil {
 		return errors.Wrap(err, "{{.PkgName}}: unable to reload all in {{$alias.UpSingular}}Slice")
 	}
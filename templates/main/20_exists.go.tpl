@@ -6,6 +6,9 @@
 {{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", " -}}
 {{- $schemaTable := .Table.Name | .SchemaTable -}}
 {{- $canSoftDelete := .Table.CanSoftDelete $.AutoColumns.Deleted }}
+{{- $hasTenant := .Table.HasTenant $.AutoColumns.Tenant }}
+{{- $args := $pkNames | join ", " }}
+{{- if $hasTenant}}{{$args = "args..."}}{{end}}
 {{if .AddGlobal -}}
 // {{$alias.UpSingular}}ExistsG checks if the {{$alias.UpSingular}} row exists.
 func {{$alias.UpSingular}}ExistsG({{if not .NoContext}}ctx context.Context, {{end -}} {{$pkArgs}}) (bool, error) {
@@ -42,33 +45,43 @@ func {{$alias.UpSingular}}ExistsGP({{if not .NoContext}}ctx context.Context, {{e
 
 // {{$alias.UpSingular}}Exists checks if the {{$alias.UpSingular}} row exists.
 func {{$alias.UpSingular}}Exists({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$pkArgs}}) (bool, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
+	{{if $hasTenant -}}
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{$.AutoColumns.Tenant}}", {{add (len .Table.PKey.Columns) 1}})
+	if err != nil {
+		return false, err
+	}
+	args := append([]interface{}{{"{"}}{{$pkNames | join ", "}}{{"}"}}, tenantArgs...)
+	{{end -}}
 	var exists bool
 	{{if .Dialect.UseCaseWhenExistsClause -}}
-	sql := "select case when exists(select top(1) 1 from {{$schemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}) then 1 else 0 end"
+	sql := "select case when exists(select top(1) 1 from {{$schemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if $hasTenant}}" + tenantWhere + "{{end}}) then 1 else 0 end"
 	{{- else -}}
-	sql := "select exists(select 1 from {{$schemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}} is null{{end}} limit 1)"
+	sql := "select exists(select 1 from {{$schemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{or $.AutoColumns.Deleted "deleted_at" | $.Quotes}} is null{{end}}{{if $hasTenant}}" + tenantWhere + "{{end}} limit 1)"
 	{{- end}}
 
 	{{if .NoContext -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, {{$pkNames | join ", "}})
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(sql, {{if $hasTenant}}args{{else}}[]interface{}{{"{"}}{{$args}}{{"}"}}{{end}})...)
 	}
 	{{else -}}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, {{$pkNames | join ", "}})
+		fmt.Fprintln(writer, boil.RedactArgs(sql, {{if $hasTenant}}args{{else}}[]interface{}{{"{"}}{{$args}}{{"}"}}{{end}})...)
 	}
 	{{end -}}
 
 	{{if .NoContext -}}
-	row := exec.QueryRow(sql, {{$pkNames | join ", "}})
+	row := boil.QueryRow(exec, sql, {{$args}})
 	{{else -}}
-	row := exec.QueryRowContext(ctx, sql, {{$pkNames | join ", "}})
+	row := boil.QueryRowContext(ctx, exec, sql, {{$args}})
 	{{- end}}
 
-	err := row.Scan(&exists)
+	err {{if not $hasTenant}}:{{end}}= row.Scan(&exists)
 	if err != nil {
 		return false, errors.Wrap(err, "{{.PkgName}}: unable to check if {{.Table.Name}} exists")
 	}
@@ -78,6 +91,9 @@ func {{$alias.UpSingular}}Exists({{if .NoContext}}exec boil.Executor{{else}}ctx
 
 // Exists checks if the {{$alias.UpSingular}} row exists.
 func (o *{{$alias.UpSingular}}) Exists({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (bool, error) {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.SelectOperation)
+	{{end -}}
 	return {{$alias.UpSingular}}Exists({{if .NoContext}}exec{{else}}ctx, exec{{end}}, o.{{$.Table.PKey.Columns | stringMap (aliasCols $alias) | join ", o."}})
 }
 
@@ -0,0 +1,550 @@
+{{- define "soft_delete_restore_correlation" -}}
+{{- $data := .Data -}}
+{{- $rel := .Rel -}}
+{{- $softDelCol := or $data.AutoColumns.Deleted "deleted_at" | $data.Quotes -}}
+{{- $childTable := $rel.ForeignTable | $data.SchemaTable -}}
+{{- $parentAlias := $data.Quotes "sd_parent" -}}
+qm.Where("{{$childTable}}.{{$softDelCol}} IN (SELECT {{$parentAlias}}.{{$softDelCol}} FROM {{$rel.Table | $data.SchemaTable}} AS {{$parentAlias}} WHERE {{$parentAlias}}.{{$rel.Column | $data.Quotes}} = {{$childTable}}.{{$rel.ForeignColumn | $data.Quotes}})")
+{{- end -}}
+
+{{- if .Table.IsView -}}
+{{- else if and .AddSoftDeletes (.Table.CanSoftDelete .AutoColumns.Deleted) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+{{- $softDelCol := or .AutoColumns.Deleted "deleted_at" -}}
+{{- $versionCol := .AutoColumns.Version -}}
+{{- $versioned := .Table.HasVersion $versionCol -}}
+{{- $versionWhere := printf "%s%s%s = " .LQ $versionCol .RQ -}}
+{{- $restoreWhereStart := 2 -}}
+{{- if $versioned}}{{$restoreWhereStart = 3}}{{end -}}
+{{- $hasTenant := .Table.HasTenant .AutoColumns.Tenant -}}
+{{- $restoreWhereCols := len .Table.PKey.Columns -}}
+{{- if $versioned}}{{$restoreWhereCols = add $restoreWhereCols 1}}{{end -}}
+{{- $ctxParams := "exec boil.Executor" -}}
+{{- $ctxArgs := "exec" -}}
+{{- if not .NoContext -}}
+{{- $ctxParams = "ctx context.Context, exec boil.ContextExecutor" -}}
+{{- $ctxArgs = "ctx, exec" -}}
+{{- end -}}
+{{- $cascades := false -}}
+{{- range .Table.ToManyRelationships -}}
+{{- if $.CascadesSoftDelete . -}}{{- $cascades = true -}}{{- end -}}
+{{- end}}
+{{if .AddGlobal -}}
+// RestoreG restores a single soft deleted {{$alias.UpSingular}} record using the global executor.
+// See Restore for more documentation.
+func (o *{{$alias.UpSingular}}) RestoreG({{if not .NoContext}}ctx context.Context{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return o.Restore({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}})
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// RestoreP restores a single soft deleted {{$alias.UpSingular}} record with an executor, and panics on error.
+// See Restore for more documentation.
+func (o *{{$alias.UpSingular}}) RestoreP({{$ctxParams}}) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end}}err := o.Restore({{$ctxArgs}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{end -}}
+}
+
+{{end -}}
+
+{{if and .AddGlobal .AddPanic -}}
+// RestoreGP restores a single soft deleted {{$alias.UpSingular}} record using the global executor, and panics on error.
+// See Restore for more documentation.
+func (o *{{$alias.UpSingular}}) RestoreGP({{if not .NoContext}}ctx context.Context{{end}}) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end}}err := o.Restore({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{end -}}
+}
+
+{{end -}}
+
+// Restore clears the {{$softDelCol}} of a soft deleted {{$alias.UpSingular}} record with an executor.
+// Restore will match against the primary key column to find the record to restore.
+{{- if $cascades}}
+// Related rows that were soft deleted along with the record are restored first.
+{{- end}}
+{{- if $versioned}}
+// The row is only matched when its {{$versionCol}} is unchanged, which is
+// then incremented. ErrStaleObject is returned when someone else changed the row first.
+{{- end}}
+{{- if $hasTenant}}
+// The row is only matched when it belongs to the tenant in the context.
+{{- end}}
+func (o *{{$alias.UpSingular}}) Restore({{$ctxParams}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpdateOperation)
+	{{end -}}
+	if o == nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: no {{$alias.UpSingular}} provided for restore")
+	}
+	{{- if $hasTenant}}
+
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{.AutoColumns.Tenant}}", {{add $restoreWhereStart $restoreWhereCols}})
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	{{- end}}
+
+	{{range .Table.ToManyRelationships -}}
+	{{- if $.CascadesSoftDelete . -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable -}}
+	if err := restore{{$ftable.UpPlural}}Cascade({{$ctxArgs}},
+		qm.Where("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}} = ?", o.{{$alias.Column .Column}}),
+		{{template "soft_delete_restore_correlation" (dict "Data" $ "Rel" .)}},
+	); err != nil {
+		return {{if not $.NoRowsAffected}}0, {{end -}} err
+	}
+
+	{{end -}}
+	{{- end -}}
+
+	deletedAt := o.{{$alias.Column $softDelCol}}
+	o.{{$alias.Column $softDelCol}} = null.Time{}
+	wl := []string{"{{$softDelCol}}"}
+	{{- if $versioned}}
+	version := o.{{$alias.Column $versionCol}}
+	o.{{$alias.Column $versionCol}}++
+	wl = append(wl, "{{$versionCol}}")
+	{{- end}}
+	sql := fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ $restoreWhereStart .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}
+		{{- if $versioned}} AND {{$versionWhere}}{{if .Dialect.UseIndexPlaceholders}}${{add (len .Table.PKey.Columns) 3}}{{else}}?{{end}}{{end}}{{if $hasTenant}}%s{{end}}",
+		strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
+		{{- if $hasTenant}}
+		tenantWhere,
+		{{- end}}
+	)
+	valueMapping, err := queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, append(wl, {{$alias.DownSingular}}PrimaryKeyColumns...))
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	{{- if $versioned}}
+	args = append(args, version)
+	{{- end}}
+	{{- if $hasTenant}}
+	args = append(args, tenantArgs...)
+	{{- end}}
+
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(sql, args)...)
+	}
+	{{else -}}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, boil.RedactArgs(sql, args)...)
+	}
+	{{end -}}
+
+	{{if and .NoRowsAffected (not $versioned) -}}
+		{{if .NoContext -}}
+	_, err = exec.Exec(sql, args...)
+		{{else -}}
+	_, err = exec.ExecContext(ctx, sql, args...)
+		{{end -}}
+	{{else -}}
+		{{if .NoContext -}}
+	result, err := exec.Exec(sql, args...)
+		{{else -}}
+	result, err := exec.ExecContext(ctx, sql, args...)
+		{{end -}}
+	{{end -}}
+	if err != nil {
+		o.{{$alias.Column $softDelCol}} = deletedAt
+		{{- if $versioned}}
+		o.{{$alias.Column $versionCol}} = version
+		{{- end}}
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to restore {{.Table.Name}} row")
+	}
+
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if or (not .NoRowsAffected) $versioned -}}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by restore for {{.Table.Name}}")
+	}
+
+	{{end -}}
+
+	{{if $versioned -}}
+	if rowsAff == 0 {
+		o.{{$alias.Column $softDelCol}} = deletedAt
+		o.{{$alias.Column $versionCol}} = version
+		return {{if not .NoRowsAffected}}0, {{end -}} ErrStaleObject
+	}
+
+	{{end -}}
+	{{if .Audited .Table.Name -}}
+	changes := map[string][2]interface{}{"{{$softDelCol}}": {deletedAt, o.{{$alias.Column $softDelCol}}}}
+	{{- if $versioned}}
+	changes["{{$versionCol}}"] = [2]interface{}{version, o.{{$alias.Column $versionCol}}}
+	{{- end}}
+	if err := o.auditUpdate(ctx, exec, changes); err != nil {
+		return {{if not .NoRowsAffected}}rowsAff, {{end -}} err
+	}
+
+	{{end -}}
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+}
+
+{{if .AddGlobal -}}
+// RestoreAllG restores all soft deleted rows matching the query using the global executor.
+func (q {{$alias.DownSingular}}Query) RestoreAllG({{if not .NoContext}}ctx context.Context{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return q.RestoreAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}})
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// RestoreAllP restores all soft deleted rows matching the query, and panics on error.
+func (q {{$alias.DownSingular}}Query) RestoreAllP({{$ctxParams}}) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end -}} err := q.RestoreAll({{$ctxArgs}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{end -}}
+}
+
+{{end -}}
+
+// RestoreAll restores all soft deleted rows matching the query.
+{{- if $cascades}}
+// Related rows that were soft deleted along with them are restored first.
+{{- end}}
+{{- if $versioned}}
+// The {{$versionCol}} of the rows is neither checked nor incremented.
+{{- end}}
+{{- if .Audited .Table.Name}}
+// The rows aren't known so they can't be audited, it fails unless the hooks of
+// the context are skipped.
+{{- end}}
+func (q {{$alias.DownSingular}}Query) RestoreAll({{$ctxParams}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpdateOperation)
+	{{end -}}
+	if q.Query == nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: no {{$alias.DownSingular}}Query provided for restore all")
+	}
+	{{- if .Audited .Table.Name}}
+	if !boil.HooksAreSkipped(ctx) {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: unable to restore all from {{.Table.Name}}, the rows of a query aren't audited, restore a slice of them instead")
+	}
+	{{- end}}
+
+	queries.SetOnlyDeleted(q.Query)
+	{{- range .Table.ToManyRelationships -}}
+	{{- if $.CascadesSoftDelete . -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable}}
+
+	queries.SetSelect(q.Query, []string{"{{$schemaTable}}.{{.Column | $.Quotes}}"})
+	if err := restore{{$ftable.UpPlural}}Cascade({{$ctxArgs}},
+		qm.WhereInQuery("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}}", q.Query),
+		{{template "soft_delete_restore_correlation" (dict "Data" $ "Rel" .)}},
+	); err != nil {
+		return {{if not $.NoRowsAffected}}0, {{end -}} err
+	}
+	{{- end -}}
+	{{- end}}
+
+	queries.SetUpdate(q.Query, M{"{{$softDelCol}}": nil})
+
+	{{if .NoRowsAffected -}}
+		{{if .NoContext -}}
+	_, err := q.Query.Exec(exec)
+		{{else -}}
+	_, err := q.Query.ExecContext(ctx, exec)
+		{{end -}}
+	{{else -}}
+		{{if .NoContext -}}
+	result, err := q.Query.Exec(exec)
+		{{else -}}
+	result, err := q.Query.ExecContext(ctx, exec)
+		{{end -}}
+	{{end -}}
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to restore all from {{.Table.Name}}")
+	}
+
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if not .NoRowsAffected -}}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by restoreall for {{.Table.Name}}")
+	}
+
+	{{end -}}
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+}
+
+{{if .AddGlobal -}}
+// RestoreAllG restores all rows in the slice using the global executor.
+func (o {{$alias.UpSingular}}Slice) RestoreAllG({{if not .NoContext}}ctx context.Context{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return o.RestoreAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}})
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// RestoreAllP restores all rows in the slice, using an executor, and panics on error.
+func (o {{$alias.UpSingular}}Slice) RestoreAllP({{$ctxParams}}) {{if not .NoRowsAffected}}int64{{end -}} {
+	{{if not .NoRowsAffected}}rowsAff, {{end -}} err := o.RestoreAll({{$ctxArgs}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+	{{- if not .NoRowsAffected}}
+
+	return rowsAff
+	{{end -}}
+}
+
+{{end -}}
+
+// RestoreAll restores all rows in the slice, using an executor.
+{{- if $cascades}}
+// Related rows that were soft deleted along with them are restored first.
+{{- end}}
+{{- if $versioned}}
+// Each row is only matched when its {{$versionCol}} is unchanged, which is then
+// incremented. ErrStaleObject is returned when fewer rows than the slice holds
+// were restored, the ones that were are only rolled back when exec is a transaction.
+{{- end}}
+{{- if $hasTenant}}
+// Only the rows of the tenant in the context are restored.
+{{- end}}
+func (o {{$alias.UpSingular}}Slice) RestoreAll({{$ctxParams}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	{{if not .NoContext -}}
+	ctx = boil.WithOperation(ctx, "{{.Table.Name}}", boil.UpdateOperation)
+	{{end -}}
+	if len(o) == 0 {
+		return {{if not .NoRowsAffected}}0, {{end -}} nil
+	}
+	{{- if $hasTenant}}
+
+	tenantWhere, tenantArgs, err := queries.TenantWhere(ctx, &dialect, "{{.AutoColumns.Tenant}}", len(o)*{{$restoreWhereCols}}+1)
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} err
+	}
+	{{- end}}
+
+	{{range .Table.ToManyRelationships -}}
+	{{- if $.CascadesSoftDelete . -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable -}}
+	{{$alias.DownSingular}}{{$ftable.UpPlural}}Args := make([]interface{}, len(o))
+	for i, obj := range o {
+		{{$alias.DownSingular}}{{$ftable.UpPlural}}Args[i] = obj.{{$alias.Column .Column}}
+	}
+	if err := restore{{$ftable.UpPlural}}Cascade({{$ctxArgs}},
+		qm.WhereIn("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}} IN ?", {{$alias.DownSingular}}{{$ftable.UpPlural}}Args...),
+		{{template "soft_delete_restore_correlation" (dict "Data" $ "Rel" .)}},
+	); err != nil {
+		return {{if not $.NoRowsAffected}}0, {{end -}} err
+	}
+
+	{{end -}}
+	{{- end -}}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$alias.DownSingular}}PrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+		{{- if $versioned}}
+		args = append(args, obj.{{$alias.Column $versionCol}})
+		{{- end}}
+	}
+	{{- if $hasTenant}}
+	args = append(args, tenantArgs...)
+	{{- end}}
+
+	sql := "UPDATE {{$schemaTable}} SET {{.LQ}}{{$softDelCol}}{{.RQ}} = NULL{{if $versioned}}, {{.LQ}}{{$versionCol}}{{.RQ}} = {{.LQ}}{{$versionCol}}{{.RQ}} + 1{{end}} WHERE {{if $hasTenant}}({{end}}" +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{if $versioned}}[]string{{"{"}}{{.Table.PKey.Columns | stringMap .StringFuncs.quoteWrap | join ", "}}, "{{$versionCol}}"{{"}"}}{{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}, len(o)){{if $hasTenant}} + ")" + tenantWhere{{end}}
+
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, boil.RedactArgs(sql, args))
+	}
+	{{else -}}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, boil.RedactArgs(sql, args))
+	}
+	{{end -}}
+
+	{{if and .NoRowsAffected (not $versioned) -}}
+		{{if .NoContext -}}
+	_, err {{if not $hasTenant}}:{{end}}= exec.Exec(sql, args...)
+		{{else -}}
+	_, err {{if not $hasTenant}}:{{end}}= exec.ExecContext(ctx, sql, args...)
+		{{end -}}
+	{{else -}}
+		{{if .NoContext -}}
+	result, err := exec.Exec(sql, args...)
+		{{else -}}
+	result, err := exec.ExecContext(ctx, sql, args...)
+		{{end -}}
+	{{end -}}
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to restore all from {{$alias.DownSingular}} slice")
+	}
+
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	{{if or (not .NoRowsAffected) $versioned -}}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by restoreall for {{.Table.Name}}")
+	}
+
+	{{end -}}
+	{{if $versioned -}}
+	if rowsAff != int64(len(o)) {
+		return {{if not .NoRowsAffected}}rowsAff, {{end -}} ErrStaleObject
+	}
+
+	{{end -}}
+	for _, obj := range o {
+		{{- if .Audited .Table.Name}}
+		deletedAt := obj.{{$alias.Column $softDelCol}}
+		{{- end}}
+		obj.{{$alias.Column $softDelCol}} = null.Time{}
+		{{- if $versioned}}
+		obj.{{$alias.Column $versionCol}}++
+		{{- end}}
+		{{- if .Audited .Table.Name}}
+		changes := map[string][2]interface{}{"{{$softDelCol}}": {deletedAt, obj.{{$alias.Column $softDelCol}}}}
+		{{- if $versioned}}
+		changes["{{$versionCol}}"] = [2]interface{}{obj.{{$alias.Column $versionCol}} - 1, obj.{{$alias.Column $versionCol}}}
+		{{- end}}
+		if err := obj.auditUpdate(ctx, exec, changes); err != nil {
+			return {{if not .NoRowsAffected}}rowsAff, {{end -}} err
+		}
+		{{- end}}
+	}
+
+	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
+}
+
+{{if .SoftDeleteCascaded .Table.Name -}}
+// softDelete{{$alias.UpPlural}}Cascade soft deletes the {{.Table.Name}} rows matching mods
+// as part of a cascade, along with the rows they cascade to in turn.
+func softDelete{{$alias.UpPlural}}Cascade({{$ctxParams}}, deletedAt time.Time, mods ...qm.QueryMod) error {
+	{{- range .Table.ToManyRelationships -}}
+	{{- if $.CascadesSoftDelete . -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable}}
+	if err := softDelete{{$ftable.UpPlural}}Cascade({{$ctxArgs}}, deletedAt,
+		qm.WhereInQuery("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}}", {{$alias.UpPlural}}(append(mods[:len(mods):len(mods)], qm.Select("{{$schemaTable}}.{{.Column | $.Quotes}}"))...).Query),
+	); err != nil {
+		return err
+	}
+	{{- end -}}
+	{{- end}}
+
+	{{- if .Audited .Table.Name}}
+	audited, err := {{$alias.DownSingular}}CascadeAudited(ctx, exec, mods)
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to cascade soft delete to {{.Table.Name}}")
+	}
+	{{- end}}
+
+	q := {{$alias.UpPlural}}(mods...)
+	queries.SetUpdate(q.Query, M{"{{$softDelCol}}": deletedAt})
+	if _, err := q.Query.{{if .NoContext}}Exec(exec){{else}}ExecContext(ctx, exec){{end}}; err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to cascade soft delete to {{.Table.Name}}")
+	}
+	{{- if .Audited .Table.Name}}
+
+	for _, obj := range audited {
+		changes := map[string][2]interface{}{"{{$softDelCol}}": {obj.{{$alias.Column $softDelCol}}, null.TimeFrom(deletedAt)}}
+		obj.{{$alias.Column $softDelCol}} = null.TimeFrom(deletedAt)
+		if err := obj.auditUpdate(ctx, exec, changes); err != nil {
+			return err
+		}
+	}
+	{{- end}}
+
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	return nil
+}
+
+// restore{{$alias.UpPlural}}Cascade restores the soft deleted {{.Table.Name}} rows matching
+// mods as part of a cascade, along with the rows they cascaded to in turn.
+func restore{{$alias.UpPlural}}Cascade({{$ctxParams}}, mods ...qm.QueryMod) error {
+	mods = append(mods, qm.OnlyDeleted())
+	{{- range .Table.ToManyRelationships -}}
+	{{- if $.CascadesSoftDelete . -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable}}
+	if err := restore{{$ftable.UpPlural}}Cascade({{$ctxArgs}},
+		qm.WhereInQuery("{{.ForeignTable | $.SchemaTable}}.{{.ForeignColumn | $.Quotes}}", {{$alias.UpPlural}}(append(mods[:len(mods):len(mods)], qm.Select("{{$schemaTable}}.{{.Column | $.Quotes}}"))...).Query),
+		{{template "soft_delete_restore_correlation" (dict "Data" $ "Rel" .)}},
+	); err != nil {
+		return err
+	}
+	{{- end -}}
+	{{- end}}
+
+	{{- if .Audited .Table.Name}}
+	audited, err := {{$alias.DownSingular}}CascadeAudited(ctx, exec, mods)
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to cascade restore to {{.Table.Name}}")
+	}
+	{{- end}}
+
+	q := {{$alias.UpPlural}}(mods...)
+	queries.SetUpdate(q.Query, M{"{{$softDelCol}}": nil})
+	if _, err := q.Query.{{if .NoContext}}Exec(exec){{else}}ExecContext(ctx, exec){{end}}; err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to cascade restore to {{.Table.Name}}")
+	}
+	{{- if .Audited .Table.Name}}
+
+	for _, obj := range audited {
+		changes := map[string][2]interface{}{"{{$softDelCol}}": {obj.{{$alias.Column $softDelCol}}, null.Time{}}}
+		obj.{{$alias.Column $softDelCol}} = null.Time{}
+		if err := obj.auditUpdate(ctx, exec, changes); err != nil {
+			return err
+		}
+	}
+	{{- end}}
+
+	{{if .NoContext}}boil.InvalidateCache("{{.Table.Name}}"){{else}}boil.InvalidateCacheContext(ctx, "{{.Table.Name}}"){{end}}
+
+	return nil
+}
+{{- if .Audited .Table.Name}}
+
+// {{$alias.DownSingular}}CascadeAudited finds the {{.Table.Name}} rows matching mods before a cascade
+// writes them, so each of them can be audited. None are needed when the hooks of
+// the context are skipped.
+func {{$alias.DownSingular}}CascadeAudited(ctx context.Context, exec boil.ContextExecutor, mods []qm.QueryMod) ({{$alias.UpSingular}}Slice, error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil, nil
+	}
+	return {{$alias.UpPlural}}(mods...).All(ctx, exec)
+}
+{{- end}}
+
+{{end -}}
+{{- end -}}
@@ -0,0 +1,126 @@
+{{- if .Table.IsView -}}
+{{- else -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+
+// {{$alias.DownSingular}}Tracking is what a {{$alias.UpSingular}} remembers to tell which of its
+// columns were changed.
+type {{$alias.DownSingular}}Tracking struct {
+	// loaded holds the values of the last snapshot, nil when there wasn't one
+	loaded *{{$alias.UpSingular}}
+	// zeros are the columns with defaults whose zero values are inserted
+	zeros []string
+}
+
+// Snapshot remembers the current column values of the {{$alias.UpSingular}} as the ones
+// it was loaded with, Changes compares against them. Objects are only tracked
+// once they're snapshotted, by calling it or by binding them with a query using
+// qm.TrackChanges. Inserting, updating, upserting and reloading a tracked
+// {{$alias.UpSingular}} snapshots the written values again.
+func (o *{{$alias.UpSingular}}) Snapshot() {
+	loaded := *o
+	loaded.tracking = nil
+	{{- if not .Table.IsJoinTable}}
+	loaded.R = nil
+	{{- end}}
+	o.tracking = &{{$alias.DownSingular}}Tracking{loaded: &loaded}
+}
+
+// refreshSnapshot snapshots a tracked {{$alias.UpSingular}} again after it was written,
+// and forgets the zero columns it was to insert.
+func (o *{{$alias.UpSingular}}) refreshSnapshot() {
+	if o.tracking == nil {
+		return
+	}
+	if o.tracking.loaded == nil {
+		o.tracking = nil
+		return
+	}
+	o.Snapshot()
+}
+
+// snapshotColumns updates the loaded values of the columns in mapping to their
+// current values after they were written by an update, if the {{$alias.UpSingular}} is tracked.
+func (o *{{$alias.UpSingular}}) snapshotColumns(mapping []uint64) {
+	if o.tracking == nil || o.tracking.loaded == nil {
+		return
+	}
+	loaded := *o.tracking.loaded
+	queries.CopyFromMapping(reflect.ValueOf(&loaded).Elem(), reflect.Indirect(reflect.ValueOf(o)), mapping)
+	o.tracking = &{{$alias.DownSingular}}Tracking{loaded: &loaded, zeros: o.tracking.zeros}
+}
+
+// KeepZeros makes the next insert of the {{$alias.UpSingular}} write the zero values of the
+// columns instead of leaving them to their database defaults. It's only needed
+// for objects that aren't tracked, the columns of tracked ones that were
+// changed to their zero values are inserted anyway.
+func (o *{{$alias.UpSingular}}) KeepZeros(columns ...string) {
+	if o.tracking == nil {
+		o.tracking = &{{$alias.DownSingular}}Tracking{}
+	}
+	o.tracking.zeros = strmangle.SetMerge(o.tracking.zeros, columns)
+}
+
+// Changes returns the columns whose values differ from the ones the {{$alias.UpSingular}}
+// was loaded with, mapped to the loaded and the current value. The columns of
+// an object that isn't tracked are compared against their zero values.
+func (o *{{$alias.UpSingular}}) Changes() map[string][2]interface{} {
+	loaded := &{{$alias.UpSingular}}{}
+	if o.tracking != nil && o.tracking.loaded != nil {
+		loaded = o.tracking.loaded
+	}
+
+	changes := make(map[string][2]interface{})
+	{{- range .Table.Columns}}
+	{{- $colAlias := $alias.Column .Name}}
+	if !reflect.DeepEqual(loaded.{{$colAlias}}, o.{{$colAlias}}) {
+		changes["{{.Name}}"] = [2]interface{}{loaded.{{$colAlias}}, o.{{$colAlias}}}
+	}
+	{{- end}}
+
+	return changes
+}
+
+// IsDirty checks if the value of the column differs from the one the {{$alias.UpSingular}}
+// was loaded with.
+func (o *{{$alias.UpSingular}}) IsDirty(column string) bool {
+	_, ok := o.Changes()[column]
+	return ok
+}
+
+// dirtyColumns returns the changed columns in the order of the table's columns.
+func (o *{{$alias.UpSingular}}) dirtyColumns() []string {
+	changes := o.Changes()
+	var cols []string
+	for _, c := range {{$alias.DownSingular}}AllColumns {
+		if _, ok := changes[c]; ok {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+
+// insertDefaults returns the columns with defaults that are inserted instead of
+// being left to the database: the ones that aren't zero, the ones passed to
+// KeepZeros, and the ones changed to their zero value since a tracked {{$alias.UpSingular}}
+// was loaded. Otherwise a zero primary key is always left to the database.
+func (o *{{$alias.UpSingular}}) insertDefaults() []string {
+	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
+	if o.tracking == nil {
+		return nzDefaults
+	}
+
+	var changes map[string][2]interface{}
+	if o.tracking.loaded != nil {
+		changes = o.Changes()
+	}
+	var cols []string
+	for _, c := range {{$alias.DownSingular}}ColumnsWithDefault {
+		if strmangle.SetInclude(c, nzDefaults) || strmangle.SetInclude(c, o.tracking.zeros) {
+			cols = append(cols, c)
+		} else if _, ok := changes[c]; ok && !strmangle.SetInclude(c, {{$alias.DownSingular}}PrimaryKeyColumns) {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+{{- end -}}
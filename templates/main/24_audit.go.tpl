@@ -0,0 +1,87 @@
+{{- if .Audited .Table.Name -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- $auditTableName := printf "%s_audit" .Table.Name}}
+{{- $auditAlias := .Aliases.Table $auditTableName}}
+{{- $auditTable := getTable .Tables $auditTableName}}
+{{- $pkArgs := .Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", "}}
+
+func init() {
+	Add{{$alias.UpSingular}}Hook(boil.AfterInsertHook, func(ctx context.Context, exec boil.ContextExecutor, o *{{$alias.UpSingular}}) error {
+		return o.audit(ctx, exec, boil.InsertOperation, o.auditValues(false))
+	})
+	Add{{$alias.UpSingular}}Hook(boil.AfterUpdateHook, func(ctx context.Context, exec boil.ContextExecutor, o *{{$alias.UpSingular}}) error {
+		// Without a snapshot the old values aren't known, so all the values are
+		// recorded as new like for an upsert
+		if o.tracking == nil || o.tracking.loaded == nil {
+			return o.audit(ctx, exec, boil.UpdateOperation, o.auditValues(false))
+		}
+		return o.audit(ctx, exec, boil.UpdateOperation, o.Changes())
+	})
+	Add{{$alias.UpSingular}}Hook(boil.AfterDeleteHook, func(ctx context.Context, exec boil.ContextExecutor, o *{{$alias.UpSingular}}) error {
+		return o.audit(ctx, exec, boil.DeleteOperation, o.auditValues(true))
+	})
+	Add{{$alias.UpSingular}}Hook(boil.AfterUpsertHook, func(ctx context.Context, exec boil.ContextExecutor, o *{{$alias.UpSingular}}) error {
+		return o.audit(ctx, exec, boil.UpsertOperation, o.auditValues(false))
+	})
+}
+
+// auditValues returns the values of all the columns of the {{$alias.UpSingular}} as the
+// changes made by inserting it, or by deleting it.
+func (o *{{$alias.UpSingular}}) auditValues(deleted bool) map[string][2]interface{} {
+	values := map[string]interface{}{
+		{{- range .Table.Columns}}
+		"{{.Name}}": o.{{$alias.Column .Name}},
+		{{- end}}
+	}
+
+	changes := make(map[string][2]interface{}, len(values))
+	for c, v := range values {
+		if deleted {
+			changes[c] = [2]interface{}{v, nil}
+		} else {
+			changes[c] = [2]interface{}{nil, v}
+		}
+	}
+	return changes
+}
+
+// audit records an operation on the {{$alias.UpSingular}} and the changes it made to its
+// columns in {{$auditTableName}}, with the actor of the context.
+func (o *{{$alias.UpSingular}}) audit(ctx context.Context, exec boil.ContextExecutor, op boil.Operation, changes map[string][2]interface{}) error {
+	{{- range .SensitiveColumns .Table}}
+	if _, ok := changes["{{.}}"]; ok {
+		changes["{{.}}"] = [2]interface{}{boil.RedactedValue, boil.RedactedValue}
+	}
+	{{- end}}
+
+	q, err := queries.AuditInsert(ctx, &dialect, "{{.SchemaTable $auditTableName}}", []interface{}{ {{- $pkArgs -}} }, op, changes)
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to audit {{.Table.Name}}")
+	}
+
+	if _, err = q.ExecContext(ctx, exec); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to insert into {{$auditTableName}}")
+	}
+
+	boil.InvalidateCacheContext(ctx, "{{$auditTableName}}")
+	return nil
+}
+
+// auditUpdate records an update of the {{$alias.UpSingular}} made without running its update
+// hooks, unless the hooks of the context are skipped.
+func (o *{{$alias.UpSingular}}) auditUpdate(ctx context.Context, exec boil.ContextExecutor, changes map[string][2]interface{}) error {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+	return o.audit(ctx, exec, boil.UpdateOperation, changes)
+}
+
+// History returns the changes recorded for the {{$alias.UpSingular}} in {{$auditTableName}},
+// oldest first.
+func (o *{{$alias.UpSingular}}) History(ctx context.Context, exec boil.ContextExecutor) ({{$auditAlias.UpSingular}}Slice, error) {
+	return {{$auditAlias.UpPlural}}(
+		qm.Where("{{.Quotes "row_id"}} = ?", queries.AuditRowID({{$pkArgs}})),
+		qm.OrderBy("{{.Quotes "changed_at"}}, {{$auditTable.PKey.Columns | .QuoteMap | join ", "}}"),
+	).All(ctx, exec)
+}
+{{- end}}
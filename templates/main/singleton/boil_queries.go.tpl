@@ -6,22 +6,40 @@ var dialect = drivers.Dialect{
 	UseLastInsertID:         {{.Dialect.UseLastInsertID}},
 	UseSchema:               {{.Dialect.UseSchema}},
 	UseDefaultKeyword:       {{.Dialect.UseDefaultKeyword}},
+	UseRowValues:            {{.Dialect.UseRowValues}},
 	UseAutoColumns:          {{.Dialect.UseAutoColumns}},
 	UseTopClause:            {{.Dialect.UseTopClause}},
 	UseOutputClause:         {{.Dialect.UseOutputClause}},
 	UseCaseWhenExistsClause: {{.Dialect.UseCaseWhenExistsClause}},
 }
 
-{{- if not .AutoColumns.Deleted }}
-    // This is a dummy variable to prevent unused regexp import error
-    var _ = &regexp.Regexp{}
-{{- end }}
+// maxQueryParams and maxInsertRows limit the size of the statements
+// created by the multi-row InsertAll methods.
+const (
+{{- if eq .DriverName "mssql"}}
+	maxQueryParams = 2000
+	maxInsertRows  = 1000
+{{- else if eq .DriverName "sqlite3"}}
+	maxQueryParams = 32766
+	maxInsertRows  = maxQueryParams
+{{- else if or (eq .DriverName "psql") (eq .DriverName "mysql")}}
+	maxQueryParams = 65535
+	maxInsertRows  = maxQueryParams
+{{- else}}
+	maxQueryParams = 999
+	maxInsertRows  = maxQueryParams
+{{- end}}
+)
+{{- if .Dialect.UseLastInsertID}}
 
-{{- if and (.AutoColumns.Deleted) (ne $.AutoColumns.Deleted "deleted_at") }}
-    func init() {
-        queries.SetRemoveSoftDeleteRgx(regexp.MustCompile("{{$.AutoColumns.Deleted}}[\"'`]? is null"))
-    }
-{{- end }}
+// InsertAllRowByRow makes the InsertAll methods insert the rows whose auto
+// increment key is generated one per statement. Otherwise the keys generated
+// by a multi-row insert are derived from the first one, which needs them to
+// be consecutive: they are when innodb_autoinc_lock_mode is 0 or 1, but with
+// the interleaved mode 2, the default of MySQL 8, only if nothing else
+// inserts into the table at the same time.
+var InsertAllRowByRow = false
+{{- end}}
 
 // NewQuery initializes a new Query using the passed in QueryMods
 func NewQuery(mods ...qm.QueryMod) *queries.Query {
@@ -31,3 +49,28 @@ func NewQuery(mods ...qm.QueryMod) *queries.Query {
 
 	return q
 }
+
+// whereRelationHelper filters rows on their related rows using correlated
+// subqueries. query builds the query for the related rows of the outer row.
+type whereRelationHelper struct {
+	query func(mods ...qm.QueryMod) *queries.Query
+}
+
+// Exists matches rows that have at least one related row matching mods.
+func (w whereRelationHelper) Exists(mods ...qm.QueryMod) qm.QueryMod {
+	return qm.WhereExists(w.query(mods...))
+}
+
+// NotExists matches rows that have no related row matching mods.
+func (w whereRelationHelper) NotExists(mods ...qm.QueryMod) qm.QueryMod {
+	return qm.WhereNotExists(w.query(mods...))
+}
+
+// CountGT matches rows that have more than n related rows matching mods.
+func (w whereRelationHelper) CountGT(n int, mods ...qm.QueryMod) qm.QueryMod {
+	return qm.QueryModFunc(func(q *queries.Query) {
+		sub := w.query(mods...)
+		queries.SetSelect(sub, []string{"COUNT(*)"})
+		queries.AppendWhereSubquery(q, fmt.Sprintf("%d <", n), sub)
+	})
+}
@@ -6,16 +6,30 @@ type M map[string]interface{}
 // fails or there was a primary key configuration that was not resolvable.
 var ErrSyncFail = errors.New("{{.PkgName}}: failed to synchronize data after insert")
 
+// ErrStaleObject occurs when updating, deleting or upserting a record with a
+// version column that no longer matches the row in the database, meaning it
+// was changed by someone else since it was loaded. Upserts into versioned
+// tables scoped to tenants also return it for conflicting rows of another tenant.
+var ErrStaleObject = errors.New("{{.PkgName}}: stale object, the row was changed since it was loaded")
+
+// errStopIteration is returned to Each by the iterators when the loop over
+// them is broken out of.
+var errStopIteration = errors.New("{{.PkgName}}: iteration stopped")
+
 type insertCache struct {
 	query        string
 	retQuery     string
 	valueMapping []uint64
 	retMapping   []uint64
+	// columns are the columns the args of query are bound to
+	columns []string
 }
 
 type updateCache struct {
 	query        string
 	valueMapping []uint64
+	// columns are the columns the args of query are bound to
+	columns []string
 }
 
 func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
@@ -0,0 +1,65 @@
+{{- if .Audited .Table.Name -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $soft := and .AddSoftDeletes (.Table.CanSoftDelete $.AutoColumns.Deleted) -}}
+{{- $pkCol := index .Table.PKey.Columns 0}}
+func test{{$alias.UpPlural}}History(t *testing.T) {
+	t.Parallel()
+
+	if len({{$alias.DownSingular}}AllColumns) == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	ctx := boil.WithActor(testContext(), "tester")
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	// Setting the primary key to itself writes the row without changing it
+	cols := M{"{{$pkCol}}": o.{{$alias.Column $pkCol}}}
+	if {{if not .NoRowsAffected}}_, {{end}}err = ({{$alias.UpSingular}}Slice{o}).UpdateAll(ctx, tx, cols); err != nil {
+		t.Error(err)
+	}
+	if {{if not .NoRowsAffected}}_, {{end}}err = {{$alias.UpPlural}}().UpdateAll(ctx, tx, cols); err == nil {
+		t.Error("want an error updating the rows of a query of an audited table")
+	}
+	if {{if not .NoRowsAffected}}_, {{end}}err = {{$alias.UpPlural}}().DeleteAll(ctx, tx{{if $soft}}, false{{end}}); err == nil {
+		t.Error("want an error deleting the rows of a query of an audited table")
+	}
+
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.Delete(ctx, tx{{if $soft}}, false{{end}}); err != nil {
+		t.Error(err)
+	}
+	{{- if $soft}}
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.Restore(ctx, tx); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+
+	history, err := o.History(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ops := []string{"insert", "update", "update", "delete"{{if $soft}}, "update"{{end}}}
+	if len(history) != len(ops) {
+		t.Fatalf("want %d audit rows, got: %d", len(ops), len(history))
+	}
+	for i, op := range ops {
+		if history[i].Operation != op {
+			t.Errorf("%d) want operation %s, got: %v", i, op, history[i].Operation)
+		}
+	}
+}
+{{- end}}
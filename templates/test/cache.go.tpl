@@ -0,0 +1,98 @@
+{{- if or .Table.IsJoinTable .Table.IsView -}}
+{{- else -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $soft := and .AddSoftDeletes (.Table.CanSoftDelete $.AutoColumns.Deleted) -}}
+{{- $pkCol := index .Table.PKey.Columns 0}}
+// test{{$alias.UpPlural}}CacheInvalidation isn't run in parallel since it sets
+// the global cache.
+func test{{$alias.UpPlural}}CacheInvalidation(t *testing.T) {
+	if len({{$alias.DownSingular}}AllColumns) == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	cache := boil.NewLRUCache(10)
+	boil.SetCache(cache)
+	defer boil.SetCache(nil)
+
+	// Each write is checked to remove a value cached for the table
+	cached := func() bool {
+		_, ok := cache.Get("{{.Table.Name}}")
+		return ok
+	}
+	write := func(op string, err error) {
+		t.Helper()
+		if err != nil {
+			t.Error(err)
+		} else if cached() {
+			t.Errorf("want the cached rows invalidated by %s", op)
+		}
+		cache.Set("{{.Table.Name}}", nil, 0, []string{"{{.Table.Name}}"})
+	}
+	cache.Set("{{.Table.Name}}", nil, 0, []string{"{{.Table.Name}}"})
+
+	{{if not .NoContext -}}
+	// Hooks are skipped to check the writes don't rely on them to invalidate
+	ctx := boil.SkipHooks(testContext())
+
+	// The writes of a transaction run by InTx invalidate once it's committed,
+	// this one is rolled back so the rows stay cached. It's run before the
+	// transaction of the other writes is begun, which would lock the tables of
+	// some databases
+	other := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, other, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	err = boil.InTx(ctx, boil.GetContextDB().(boil.ContextBeginner), nil, func(ctx context.Context, tx boil.ContextExecutor) error {
+		if err := other.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+		if !cached() {
+			t.Error("want the cached rows kept until the transaction is committed")
+		}
+		return errTestRollback
+	})
+	if err != errTestRollback {
+		t.Error("want the transaction rolled back, got:", err)
+	}
+	if !cached() {
+		t.Error("want the cached rows kept when the transaction is rolled back")
+	}
+	{{end -}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+
+	write("Insert", o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()))
+	{{- if .NoRowsAffected}}
+	write("Update", o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()))
+	{{- else}}
+	_, err = o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer())
+	write("Update", err)
+	{{- end}}
+
+	// Setting the primary key to itself writes the row without changing it
+	cols := M{"{{$pkCol}}": o.{{$alias.Column $pkCol}}}
+	{{- if .NoRowsAffected}}
+	write("UpdateAll", ({{$alias.UpSingular}}Slice{o}).UpdateAll({{if not .NoContext}}ctx, {{end -}} tx, cols))
+	write("Delete", o.Delete({{if not .NoContext}}ctx, {{end -}} tx{{if $soft}}, false{{end}}))
+	{{- if $soft}}
+	write("Restore", o.Restore({{if not .NoContext}}ctx, {{end -}} tx))
+	{{- end}}
+	{{- else}}
+	_, err = ({{$alias.UpSingular}}Slice{o}).UpdateAll({{if not .NoContext}}ctx, {{end -}} tx, cols)
+	write("UpdateAll", err)
+	_, err = o.Delete({{if not .NoContext}}ctx, {{end -}} tx{{if $soft}}, false{{end}})
+	write("Delete", err)
+	{{- if $soft}}
+	_, err = o.Restore({{if not .NoContext}}ctx, {{end -}} tx)
+	write("Restore", err)
+	{{- end}}
+	{{- end}}
+}
+{{- end}}
@@ -1,6 +1,9 @@
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $canSoftDelete := .Table.CanSoftDelete $.AutoColumns.Deleted -}}
 {{- $soft := and .AddSoftDeletes $canSoftDelete }}
+{{- $softDelCol := or .AutoColumns.Deleted "deleted_at"}}
+{{- $versionCol := .AutoColumns.Version}}
+{{- $versioned := .Table.HasVersion $versionCol}}
 {{if $soft -}}
 func test{{$alias.UpPlural}}SoftDelete(t *testing.T) {
 	t.Parallel()
@@ -12,13 +15,24 @@ func test{{$alias.UpPlural}}SoftDelete(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
 		t.Error(err)
 	}
 
+	{{if $versioned -}}
+	stale := *o
+	stale.{{$alias.Column $versionCol}}--
+	if {{if not .NoRowsAffected}}_, {{end}}err = stale.Delete({{if not .NoContext}}ctx, {{end -}} tx, false); err != ErrStaleObject {
+		t.Error("want a stale object error, got:", err)
+	}
+	if stale.{{$alias.Column $softDelCol}} != o.{{$alias.Column $softDelCol}} || stale.{{$alias.Column $versionCol}} != o.{{$alias.Column $versionCol}}-1 {
+		t.Error("a stale object should not be changed")
+	}
+
+	{{end -}}
 	{{if .NoRowsAffected -}}
 	if err = o.Delete({{if not .NoContext}}ctx, {{end -}} tx, false); err != nil {
 		t.Error(err)
@@ -53,13 +67,18 @@ func test{{$alias.UpPlural}}QuerySoftDeleteAll(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
 		t.Error(err)
 	}
 
+	{{if .Audited .Table.Name -}}
+	// The rows of query deletes aren't audited, they fail without skipping hooks
+	ctx = boil.SkipHooks(ctx)
+
+	{{end -}}
 	{{if .NoRowsAffected -}}
 	if err = {{$alias.UpPlural}}().DeleteAll({{if not .NoContext}}ctx, {{end -}} tx, false); err != nil {
 		t.Error(err)
@@ -94,7 +113,7 @@ func test{{$alias.UpPlural}}SliceSoftDeleteAll(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -103,6 +122,17 @@ func test{{$alias.UpPlural}}SliceSoftDeleteAll(t *testing.T) {
 
 	slice := {{$alias.UpSingular}}Slice{{"{"}}o{{"}"}}
 
+	{{if $versioned -}}
+	stale := *o
+	stale.{{$alias.Column $versionCol}}--
+	if {{if not .NoRowsAffected}}_, {{end}}err = ({{$alias.UpSingular}}Slice{&stale}).DeleteAll({{if not .NoContext}}ctx, {{end -}} tx, false); err != ErrStaleObject {
+		t.Error("want a stale object error, got:", err)
+	}
+	if stale.{{$alias.Column $softDelCol}} != o.{{$alias.Column $softDelCol}} || stale.{{$alias.Column $versionCol}} != o.{{$alias.Column $versionCol}}-1 {
+		t.Error("a stale object should not be changed")
+	}
+
+	{{end -}}
 	{{if .NoRowsAffected -}}
 	if err = slice.DeleteAll({{if not .NoContext}}ctx, {{end -}} tx, false); err != nil {
 		t.Error(err)
@@ -125,6 +155,94 @@ func test{{$alias.UpPlural}}SliceSoftDeleteAll(t *testing.T) {
 	if count != 0 {
 		t.Error("want zero records, got:", count)
 	}
+	{{- if $versioned}}
+
+	stale = *o
+	stale.{{$alias.Column $versionCol}}--
+	if {{if not .NoRowsAffected}}_, {{end}}err = ({{$alias.UpSingular}}Slice{&stale}).RestoreAll({{if not .NoContext}}ctx, {{end -}} tx); err != ErrStaleObject {
+		t.Error("want a stale object error, got:", err)
+	}
+	if {{if not .NoRowsAffected}}_, {{end}}err = slice.RestoreAll({{if not .NoContext}}ctx, {{end -}} tx); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+}
+
+func test{{$alias.UpPlural}}Restore(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	{{if .NoRowsAffected -}}
+	if err = o.Delete({{if not .NoContext}}ctx, {{end -}} tx, false); err != nil {
+		t.Error(err)
+	}
+	if err = o.Restore({{if not .NoContext}}ctx, {{end -}} tx); err != nil {
+		t.Error(err)
+	}
+
+	{{else -}}
+	if _, err = o.Delete({{if not .NoContext}}ctx, {{end -}} tx, false); err != nil {
+		t.Error(err)
+	}
+	if rowsAff, err := o.Restore({{if not .NoContext}}ctx, {{end -}} tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have restored one row, but affected:", rowsAff)
+	}
+
+	{{end -}}
+
+	if o.{{$alias.Column (or $.AutoColumns.Deleted "deleted_at")}}.Valid {
+		t.Error("want {{or $.AutoColumns.Deleted "deleted_at"}} to be cleared")
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.Delete({{if not .NoContext}}ctx, {{end -}} tx, false); err != nil {
+		t.Error(err)
+	}
+	{{if .Audited .Table.Name -}}
+	// The rows of query restores aren't audited, they fail without skipping hooks
+	if {{if not .NoRowsAffected}}_, {{end}}err = {{$alias.UpPlural}}().RestoreAll(ctx, tx); err == nil {
+		t.Error("want an error restoring the rows of a query of an audited table")
+	}
+	if {{if not .NoRowsAffected}}_, {{end}}err = {{$alias.UpPlural}}().RestoreAll(boil.SkipHooks(ctx), tx); err != nil {
+		t.Error(err)
+	}
+	{{- else}}
+	if {{if not .NoRowsAffected}}_, {{end}}err = {{$alias.UpPlural}}().RestoreAll({{if not .NoContext}}ctx, {{end -}} tx); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+
+	count, err = {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record after restore all, got:", count)
+	}
 }
 
 {{end -}}
@@ -139,7 +257,7 @@ func test{{$alias.UpPlural}}Delete(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -180,13 +298,18 @@ func test{{$alias.UpPlural}}QueryDeleteAll(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
 		t.Error(err)
 	}
 
+	{{if .Audited .Table.Name -}}
+	// The rows of query deletes aren't audited, they fail without skipping hooks
+	ctx = boil.SkipHooks(ctx)
+
+	{{end -}}
 	{{if .NoRowsAffected -}}
 	if err = {{$alias.UpPlural}}().DeleteAll({{if not .NoContext}}ctx, {{end -}} tx {{- if $soft}}, true{{end}}); err != nil {
 		t.Error(err)
@@ -221,7 +344,7 @@ func test{{$alias.UpPlural}}SliceDeleteAll(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -9,7 +9,7 @@ func test{{$alias.UpPlural}}Bind(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -31,7 +31,7 @@ func test{{$alias.UpPlural}}One(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -59,7 +59,7 @@ func test{{$alias.UpPlural}}All(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = {{$alias.DownSingular}}One.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -79,6 +79,61 @@ func test{{$alias.UpPlural}}All(t *testing.T) {
 	}
 }
 
+func test{{$alias.UpPlural}}Each(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	{{$alias.DownSingular}}One := &{{$alias.UpSingular}}{}
+	{{$alias.DownSingular}}Two := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, {{$alias.DownSingular}}Two, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = {{$alias.DownSingular}}One.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = {{$alias.DownSingular}}Two.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count := 0
+	err = {{$alias.UpPlural}}(qm.BatchSize(1)).Each({{if not .NoContext}}ctx, {{end -}} tx, func(o *{{$alias.UpSingular}}) error {
+		if o == nil {
+			t.Error("expected to get a non nil record")
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+
+	count = 0
+	for o, err := range {{$alias.UpPlural}}().Iter({{if not .NoContext}}ctx, {{end -}} tx) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o == nil {
+			t.Error("expected to get a non nil record")
+		}
+		count++
+		break
+	}
+	if count != 1 {
+		t.Error("want the loop to stop after 1 record, got:", count)
+	}
+}
+
 func test{{$alias.UpPlural}}Count(t *testing.T) {
 	t.Parallel()
 
@@ -93,7 +148,7 @@ func test{{$alias.UpPlural}}Count(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = {{$alias.DownSingular}}One.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -112,3 +167,129 @@ func test{{$alias.UpPlural}}Count(t *testing.T) {
 		t.Error("want 2 records, got:", count)
 	}
 }
+
+func test{{$alias.UpPlural}}Paginate(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	{{$alias.DownSingular}}One := &{{$alias.UpSingular}}{}
+	{{$alias.DownSingular}}Two := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, {{$alias.DownSingular}}Two, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = {{$alias.DownSingular}}One.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = {{$alias.DownSingular}}Two.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	first, cursors, err := {{$alias.UpPlural}}().Paginate({{if not .NoContext}}ctx, {{end -}} tx, qm.PageAfter("", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 1 {
+		t.Fatal("want 1 record, got:", len(first))
+	}
+	if len(cursors.Next) == 0 || len(cursors.Prev) != 0 {
+		t.Errorf("want only a next cursor, got: %#v", cursors)
+	}
+
+	second, cursors, err := {{$alias.UpPlural}}().Paginate({{if not .NoContext}}ctx, {{end -}} tx, qm.PageAfter(cursors.Next, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second) != 1 {
+		t.Fatal("want 1 record, got:", len(second))
+	}
+	if len(cursors.Next) != 0 || len(cursors.Prev) == 0 {
+		t.Errorf("want only a prev cursor, got: %#v", cursors)
+	}
+}
+
+{{- $maxColumn := ""}}
+{{- $groupColumn := ""}}
+{{- range $column := .Table.Columns}}
+{{- if and (not $maxColumn) (aggregateType "MAX" $column.Type)}}{{$maxColumn = $column.Name}}{{end}}
+{{- if and (not $groupColumn) (or (isPrimitive $column.Type) (isNullPrimitive $column.Type) (eq $column.Type "bool" "null.Bool"))}}{{$groupColumn = $column.Name}}{{end}}
+{{- end}}
+
+func test{{$alias.UpPlural}}Aggregates(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	{{$alias.DownSingular}}One := &{{$alias.UpSingular}}{}
+	{{$alias.DownSingular}}Two := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, {{$alias.DownSingular}}One, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = randomize.Struct(seed, {{$alias.DownSingular}}Two, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = {{$alias.DownSingular}}One.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = {{$alias.DownSingular}}Two.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	{{- if $maxColumn}}
+	{{- $colAlias := $alias.Column $maxColumn}}
+
+	max, err := {{$alias.UpPlural}}().Max{{$colAlias}}({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if !max.Valid {
+		t.Error("want a max of {{$maxColumn}}, got null")
+	}
+	{{- end}}
+	{{- if $groupColumn}}
+	{{- $colAlias := $alias.Column $groupColumn}}
+
+	counts, err := {{$alias.UpPlural}}().GroupCount{{$colAlias}}({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+	var total int64
+	for _, count := range counts {
+		total += count
+	}
+	if total != 2 {
+		t.Error("want 2 records counted by {{$groupColumn}}, got:", total)
+	}
+	{{- end}}
+}
+
+func test{{$alias.UpPlural}}Explain(t *testing.T) {
+	t.Parallel()
+	{{- if .Dialect.UseTopClause}}
+
+	t.Skip("explain is not supported for mssql")
+	{{- else}}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+
+	plan, err := {{$alias.UpPlural}}().Explain({{if not .NoContext}}ctx, {{end -}} tx, queries.ExplainOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Nodes) == 0 || len(plan.String()) == 0 {
+		t.Error("want a query plan, got an empty one")
+	}
+	{{- end}}
+}
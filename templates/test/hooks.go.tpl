@@ -46,11 +46,16 @@ func {{$alias.DownSingular}}AfterUpsertHook({{if .NoContext}}e boil.Executor{{el
 }
 
 func test{{$alias.UpPlural}}Hooks(t *testing.T) {
+	{{- if .Audited .Table.Name}}
+	// Not parallel, the audit hooks are taken out during the test and no other
+	// test may write the table without them
+	{{- else}}
 	t.Parallel()
+	{{- end}}
 
 	var err error
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	empty := &{{$alias.UpSingular}}{}
 	o := &{{$alias.UpSingular}}{}
 
@@ -58,6 +63,21 @@ func test{{$alias.UpPlural}}Hooks(t *testing.T) {
 	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, false); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} object: %s", err)
 	}
+	{{- if .Audited .Table.Name}}
+
+	// The audit hooks need a database, put them back after the test
+	auditHooks := [][]{{$alias.UpSingular}}Hook{ {{- $alias.DownSingular}}AfterInsertHooks, {{$alias.DownSingular}}AfterUpdateHooks, {{$alias.DownSingular}}AfterDeleteHooks, {{$alias.DownSingular}}AfterUpsertHooks}
+	defer func() {
+		{{$alias.DownSingular}}AfterInsertHooks = auditHooks[0]
+		{{$alias.DownSingular}}AfterUpdateHooks = auditHooks[1]
+		{{$alias.DownSingular}}AfterDeleteHooks = auditHooks[2]
+		{{$alias.DownSingular}}AfterUpsertHooks = auditHooks[3]
+	}()
+	{{$alias.DownSingular}}AfterInsertHooks = nil
+	{{$alias.DownSingular}}AfterUpdateHooks = nil
+	{{$alias.DownSingular}}AfterDeleteHooks = nil
+	{{$alias.DownSingular}}AfterUpsertHooks = nil
+	{{- end}}
 
 	Add{{$alias.UpSingular}}Hook(boil.BeforeInsertHook, {{$alias.DownSingular}}BeforeInsertHook)
 	if err = o.doBeforeInsertHooks({{if not .NoContext}}ctx, {{end -}} nil); err != nil {
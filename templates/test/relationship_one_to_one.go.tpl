@@ -6,9 +6,10 @@
 		{{- $relAlias := $ftable.Relationship $rel.Name -}}
 		{{- $usesPrimitives := usesPrimitives $.Tables $rel.Table $rel.Column $rel.ForeignTable $rel.ForeignColumn -}}
 		{{- $colField := $ltable.Column $rel.Column -}}
-		{{- $fcolField := $ftable.Column $rel.ForeignColumn }}
+		{{- $fcolField := $ftable.Column $rel.ForeignColumn -}}
+		{{- $pkCol := index $.Table.PKey.Columns 0 }}
 func test{{$ltable.UpSingular}}OneToOne{{$ftable.UpSingular}}Using{{$relAlias.Local}}(t *testing.T) {
-	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	{{if not $.NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 
@@ -78,6 +79,37 @@ func test{{$ltable.UpSingular}}OneToOne{{$ftable.UpSingular}}Using{{$relAlias.Lo
 		t.Error("failed to run AfterSelect hook for relationship")
 	}
 	{{- end}}
+
+	{{if not $.NoHooks -}}
+	ranAfterSelectHook = false
+	{{end -}}
+	joined, err := {{$ltable.UpPlural}}(
+		qm.LoadJoined("{{$relAlias.Local}}"),
+		qm.Where("{{$.Table.Name | $.SchemaTable}}.{{$pkCol | $.Quotes}} = ?", local.{{$ltable.Column $pkCol}}),
+	).One({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if joined.R == nil || joined.R.{{$relAlias.Local}} == nil {
+		t.Fatal("struct should have been joined")
+	}
+	{{if $usesPrimitives -}}
+	if joined.R.{{$relAlias.Local}}.{{$fcolField}} != foreign.{{$fcolField}} {
+	{{else -}}
+	if !queries.Equal(joined.R.{{$relAlias.Local}}.{{$fcolField}}, foreign.{{$fcolField}}) {
+	{{end -}}
+		t.Errorf("want: %v, got %v", foreign.{{$fcolField}}, joined.R.{{$relAlias.Local}}.{{$fcolField}})
+	}
+	{{- if not $.NoBackReferencing}}
+	if joined.R.{{$relAlias.Local}}.R == nil || joined.R.{{$relAlias.Local}}.R.{{$relAlias.Foreign}} != joined {
+		t.Error("joined struct should reference the struct it's joined to")
+	}
+	{{- end}}
+	{{- if not $.NoHooks}}
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for joined relationship")
+	}
+	{{- end}}
 }
 
 {{end -}}{{/* range */}}
@@ -11,7 +11,7 @@
 		{{- $schemaForeignTable := .ForeignTable | $.SchemaTable }}
 func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 	var err error
-	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	{{if not $.NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 
@@ -108,6 +108,43 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
+	if err = a.L.Count{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, false, (*[]*{{$ltable.UpSingular}})(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := a.R.Count{{$relAlias.Local}}(); got != 2 {
+		t.Error("number of eager loaded counted records wrong, got:", got)
+	}
+
+	a.R.counts = nil
+	if err = a.L.Count{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := a.R.Count{{$relAlias.Local}}(); got != 2 {
+		t.Error("number of eager loaded counted records wrong, got:", got)
+	}
+
+	count, err := {{$ltable.UpPlural}}({{$ltable.UpSingular}}Where.{{$relAlias.Local}}.Exists()).Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Error("number of records with related records wrong, got:", count)
+	}
+	count, err = {{$ltable.UpPlural}}({{$ltable.UpSingular}}Where.{{$relAlias.Local}}.CountGT(1)).Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Error("number of records with more than 1 related record wrong, got:", count)
+	}
+	count, err = {{$ltable.UpPlural}}({{$ltable.UpSingular}}Where.{{$relAlias.Local}}.CountGT(2)).Count({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Error("number of records with more than 2 related records wrong, got:", count)
+	}
+
 	if t.Failed() {
 		t.Logf("%#v", check)
 	}
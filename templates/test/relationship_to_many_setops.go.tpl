@@ -11,7 +11,7 @@
 func test{{$ltable.UpSingular}}ToManyAddOp{{$relAlias.Local}}(t *testing.T) {
 	var err error
 
-	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	{{if not $.NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 
@@ -107,7 +107,7 @@ func test{{$ltable.UpSingular}}ToManyAddOp{{$relAlias.Local}}(t *testing.T) {
 func test{{$ltable.UpSingular}}ToManySetOp{{$relAlias.Local}}(t *testing.T) {
 	var err error
 
-	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	{{if not $.NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 
@@ -228,7 +228,7 @@ func test{{$ltable.UpSingular}}ToManySetOp{{$relAlias.Local}}(t *testing.T) {
 func test{{$ltable.UpSingular}}ToManyRemoveOp{{$relAlias.Local}}(t *testing.T) {
 	var err error
 
-	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	{{if not $.NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 
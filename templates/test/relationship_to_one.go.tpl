@@ -6,9 +6,10 @@
 		{{- $rel := $ltable.Relationship $fkey.Name -}}
 		{{- $colField := $ltable.Column $fkey.Column -}}
 		{{- $fcolField := $ftable.Column $fkey.ForeignColumn -}}
-		{{- $usesPrimitives := usesPrimitives $.Tables $fkey.Table $fkey.Column $fkey.ForeignTable $fkey.ForeignColumn }}
+		{{- $usesPrimitives := usesPrimitives $.Tables $fkey.Table $fkey.Column $fkey.ForeignTable $fkey.ForeignColumn -}}
+		{{- $pkCol := index $.Table.PKey.Columns 0 }}
 func test{{$ltable.UpSingular}}ToOne{{$ftable.UpSingular}}Using{{$rel.Foreign}}(t *testing.T) {
-	{{if not $.NoContext}}ctx := context.Background(){{end}}
+	{{if not $.NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if $.NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 
@@ -78,6 +79,41 @@ func test{{$ltable.UpSingular}}ToOne{{$ftable.UpSingular}}Using{{$rel.Foreign}}(
 		t.Error("failed to run AfterSelect hook for relationship")
 	}
 	{{- end}}
+
+	{{if not $.NoHooks -}}
+	ranAfterSelectHook = false
+	{{end -}}
+	joined, err := {{$ltable.UpPlural}}(
+		qm.LoadJoined("{{$rel.Foreign}}"),
+		qm.Where("{{$.Table.Name | $.SchemaTable}}.{{$pkCol | $.Quotes}} = ?", local.{{$ltable.Column $pkCol}}),
+	).One({{if not $.NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if joined.R == nil || joined.R.{{$rel.Foreign}} == nil {
+		t.Fatal("struct should have been joined")
+	}
+	{{if $usesPrimitives -}}
+	if joined.R.{{$rel.Foreign}}.{{$fcolField}} != foreign.{{$fcolField}} {
+	{{else -}}
+	if !queries.Equal(joined.R.{{$rel.Foreign}}.{{$fcolField}}, foreign.{{$fcolField}}) {
+	{{end -}}
+		t.Errorf("want: %v, got %v", foreign.{{$fcolField}}, joined.R.{{$rel.Foreign}}.{{$fcolField}})
+	}
+	{{- if not $.NoBackReferencing}}
+	{{if $fkey.Unique -}}
+	if joined.R.{{$rel.Foreign}}.R == nil || joined.R.{{$rel.Foreign}}.R.{{$rel.Local}} != joined {
+	{{- else -}}
+	if joined.R.{{$rel.Foreign}}.R == nil || len(joined.R.{{$rel.Foreign}}.R.{{$rel.Local}}) != 1 || joined.R.{{$rel.Foreign}}.R.{{$rel.Local}}[0] != joined {
+	{{- end}}
+		t.Error("joined struct should reference the struct it's joined to")
+	}
+	{{- end}}
+	{{- if not $.NoHooks}}
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for joined relationship")
+	}
+	{{- end}}
 }
 
 {{end -}}{{/* range */}}
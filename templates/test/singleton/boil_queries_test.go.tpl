@@ -16,6 +16,37 @@ func MustTx(transactor boil.ContextTransactor, err error) boil.ContextTransactor
 }
 {{- end}}
 
+{{- if not .NoContext}}
+{{- $tenantTable := ""}}
+{{- range .Tables}}
+	{{- if and (not $tenantTable) (not .IsView) (.HasTenant $.AutoColumns.Tenant)}}
+		{{- $tenantTable = .Name}}
+	{{- end}}
+{{- end}}
+{{if $tenantTable}}
+{{- $alias := .Aliases.Table $tenantTable}}
+// testTenant is the tenant of the rows the tests create
+var testTenant = func() interface{} {
+	o := &{{$alias.UpSingular}}{}
+	if err := randomize.Struct(randomize.NewSeed(), o, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		panic(fmt.Sprintf("Unable to randomize the test tenant: %s", err))
+	}
+	return o.{{$alias.Column $.AutoColumns.Tenant}}
+}()
+{{end}}
+// errTestRollback is returned by the transactions the tests roll back
+var errTestRollback = fmt.Errorf("rolled back by the test")
+
+// testContext returns the context the tests run queries with
+func testContext() context.Context {
+	{{if $tenantTable -}}
+	return boil.WithTenant(context.Background(), testTenant)
+	{{- else -}}
+	return context.Background()
+	{{- end}}
+}
+{{- end}}
+
 func newFKeyDestroyer(regex *regexp.Regexp, reader io.Reader) io.Reader {
 	return &fKeyDestroyer{
 		reader: reader,
@@ -50,6 +50,18 @@ func TestSliceSoftDeleteAll(t *testing.T) {
   {{end -}}
   {{- end -}}
 }
+
+func TestRestore(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  	{{- if .CanSoftDelete $.AutoColumns.Deleted -}}
+      {{- $alias := $.Aliases.Table .Name -}}
+      t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Restore)
+  	{{end -}}
+  {{end -}}
+  {{- end -}}
+}
 {{- end}}
 
 func TestDelete(t *testing.T) {
@@ -132,6 +144,16 @@ func TestAll(t *testing.T) {
   {{- end -}}
 }
 
+func TestEach(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Each)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestCount(t *testing.T) {
   {{- range .Tables}}
   {{- if or .IsJoinTable .IsView -}}
@@ -142,6 +164,36 @@ func TestCount(t *testing.T) {
   {{- end -}}
 }
 
+func TestAggregates(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Aggregates)
+  {{end -}}
+  {{- end -}}
+}
+
+func TestExplain(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Explain)
+  {{end -}}
+  {{- end -}}
+}
+
+func TestPaginate(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Paginate)
+  {{end -}}
+  {{- end -}}
+}
+
 {{if not .NoHooks -}}
 func TestHooks(t *testing.T) {
   {{- range .Tables}}
@@ -165,6 +217,16 @@ func TestInsert(t *testing.T) {
   {{- end -}}
 }
 
+func TestSliceInsertAll(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SliceInsertAll)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestReload(t *testing.T) {
   {{- range .Tables}}
   {{- if or .IsJoinTable .IsView -}}
@@ -205,6 +267,50 @@ func TestUpdate(t *testing.T) {
   {{- end -}}
 }
 
+func TestUpdateDirty(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}UpdateDirty)
+  {{end -}}
+  {{- end -}}
+}
+
+{{- if not .NoContext}}
+
+func TestTenant(t *testing.T) {
+  {{- range .Tables}}
+  {{- if and (not .IsView) (.HasTenant $.AutoColumns.Tenant) -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Tenant)
+  {{end -}}
+  {{- end -}}
+}
+{{- end}}
+
+{{- if .Audit}}
+
+func TestHistory(t *testing.T) {
+  {{- range .Tables}}
+  {{- if $.Audited .Name -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}History)
+  {{end -}}
+  {{- end -}}
+}
+{{- end}}
+
+func TestCacheInvalidation(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}CacheInvalidation)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestSliceUpdateAll(t *testing.T) {
   {{- range .Tables}}
   {{- if or .IsJoinTable .IsView -}}
@@ -0,0 +1,107 @@
+{{- if and (not .NoContext) (not .Table.IsView) (.Table.HasTenant .AutoColumns.Tenant) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $tenantField := $alias.Column .AutoColumns.Tenant -}}
+{{- $versioned := .Table.HasVersion .AutoColumns.Version -}}
+{{- $soft := and .AddSoftDeletes (.Table.CanSoftDelete .AutoColumns.Deleted) -}}
+{{- $pkArgs := .Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice (printf "%s." "o") | join ", " -}}
+func test{{$alias.UpPlural}}Tenant(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	other := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, other, {{$alias.DownSingular}}DBTypes, false); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	ctx := testContext()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if o.{{$tenantField}} == other.{{$tenantField}} {
+		t.Skip("Skipping a random tenant that is the test tenant")
+	}
+
+	// The same query is run for each tenant to check it's scoped to the
+	// tenant of every context it's run with
+	otherCtx := boil.WithTenant(ctx, other.{{$tenantField}})
+	query := {{$alias.UpPlural}}()
+	if count, err := query.Count(ctx, tx); err != nil || count != 1 {
+		t.Error("want one record for the tenant, got:", count, err)
+	}
+	if count, err := query.Count(otherCtx, tx); err != nil || count != 0 {
+		t.Error("want no records for another tenant, got:", count, err)
+	}
+	if _, err = query.Count(boil.WithTenant(ctx, nil), tx); err == nil {
+		t.Error("want an error without a tenant")
+	}
+	if count, err := {{$alias.UpPlural}}(qm.AllTenants()).Count(otherCtx, tx); err != nil || count != 1 {
+		t.Error("want one record for all tenants, got:", count, err)
+	}
+
+	if found, err := Find{{$alias.UpSingular}}(otherCtx, tx, {{$pkArgs}}); err == nil || found != nil {
+		t.Error("want no record found for another tenant")
+	}
+	if exists, err := {{$alias.UpSingular}}Exists(otherCtx, tx, {{$pkArgs}}); err != nil || exists {
+		t.Error("want no record for another tenant, got:", exists, err)
+	}
+	if err = o.Reload(otherCtx, tx); err == nil {
+		t.Error("want no record reloaded for another tenant")
+	}
+
+	// Objects of another tenant are only found for all tenants, and neither
+	// updated nor deleted by the tenant
+	found, err := Find{{$alias.UpSingular}}(boil.WithAllTenants(otherCtx), tx, {{$pkArgs}})
+	if err != nil {
+		t.Fatal("want the record found for all tenants, got:", err)
+	}
+	{{if .NoRowsAffected}}err = {{else if $versioned}}_, err = {{else}}rowsAff, err := {{end}}found.Update(otherCtx, tx, boil.Infer())
+	{{- if $versioned}}
+	if err != ErrStaleObject {
+		t.Error("want a stale object updating for another tenant, got:", err)
+	}
+	{{- else if not .NoRowsAffected}}
+	if err != nil || rowsAff != 0 {
+		t.Error("want no record updated for another tenant, got:", rowsAff, err)
+	}
+	{{- else}}
+	if err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+	{{if .NoRowsAffected}}err = {{else if $versioned}}_, err = {{else}}rowsAff, err = {{end}}found.Delete(otherCtx, tx{{if $soft}}, true{{end}})
+	{{- if $versioned}}
+	if err != ErrStaleObject {
+		t.Error("want a stale object deleting for another tenant, got:", err)
+	}
+	{{- else if not .NoRowsAffected}}
+	if err != nil || rowsAff != 0 {
+		t.Error("want no record deleted for another tenant, got:", rowsAff, err)
+	}
+	{{- else}}
+	if err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+	if exists, err := {{$alias.UpSingular}}Exists(ctx, tx, {{$pkArgs}}); err != nil || !exists {
+		t.Error("want the record kept by the tenant, got:", exists, err)
+	}
+
+	inserted := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, inserted, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = inserted.Insert(otherCtx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if inserted.{{$tenantField}} != other.{{$tenantField}} {
+		t.Error("want the inserted row given the tenant of the context, got:", inserted.{{$tenantField}})
+	}
+}
+{{- end}}
@@ -1,4 +1,12 @@
 {{- $alias := .Aliases.Table .Table.Name}}
+{{- $versionCol := .AutoColumns.Version}}
+{{- $versioned := .Table.HasVersion $versionCol}}
+{{- /* Update sets neither versions nor tenants, don't randomize them */}}
+{{- $keep := ""}}
+{{- if $versioned}}{{$keep = printf "%q" $versionCol}}{{end}}
+{{- if .Table.HasTenant .AutoColumns.Tenant}}
+{{- if $keep}}{{$keep = printf "%s, %q" $keep .AutoColumns.Tenant}}{{else}}{{$keep = printf "%q" .AutoColumns.Tenant}}{{end}}
+{{- end}}
 func test{{$alias.UpPlural}}Update(t *testing.T) {
 	t.Parallel()
 
@@ -16,7 +24,7 @@ func test{{$alias.UpPlural}}Update(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -32,7 +40,7 @@ func test{{$alias.UpPlural}}Update(t *testing.T) {
 		t.Error("want one record, got:", count)
 	}
 
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{if $versioned}}append([]string{"{{$versionCol}}"}, {{$alias.DownSingular}}PrimaryKeyColumns...){{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -47,6 +55,118 @@ func test{{$alias.UpPlural}}Update(t *testing.T) {
 		t.Error("should only affect one row but affected", rowsAff)
 	}
 	{{end -}}
+	{{- if $versioned}}
+
+	stale := *o
+	stale.{{$alias.Column $versionCol}}--
+	if {{if not .NoRowsAffected}}_, {{end}}err = stale.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != ErrStaleObject {
+		t.Error("want a stale object error, got:", err)
+	}
+	if stale.{{$alias.Column $versionCol}} != o.{{$alias.Column $versionCol}}-1 {
+		t.Error("the version of a stale object should not change")
+	}
+	{{- end}}
+}
+
+func test{{$alias.UpPlural}}UpdateDirty(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len({{$alias.DownSingular}}AllColumns) == len({{$alias.DownSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := testContext(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	{{$alias.DownSingular}}Tracked, err := {{$alias.UpPlural}}(qm.TrackChanges(), qm.Where("{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}", {{.Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice (printf "%s." "o") | join ", "}})).One({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if {{$alias.DownSingular}}Tracked.tracking == nil {
+		t.Fatal("want the object tracked")
+	}
+	o = {{$alias.DownSingular}}Tracked
+
+	if changes := o.Changes(); len(changes) != 0 {
+		t.Error("want no changes after loading, got:", changes)
+	}
+
+	{{if .NoRowsAffected -}}
+	if err = o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Dirty()); err != nil {
+		t.Error(err)
+	}
+	{{else -}}
+	if rowsAff, err := o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Dirty()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 0 {
+		t.Error("should not update an unchanged row but affected", rowsAff)
+	}
+	{{end -}}
+
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{if $keep}}append([]string{{"{"}}{{$keep}}{{"}"}}, {{$alias.DownSingular}}PrimaryKeyColumns...){{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if len(o.Changes()) == 0 {
+		t.Skip("Randomizing didn't change any column")
+	}
+
+	{{if .NoRowsAffected -}}
+	if err = o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Dirty()); err != nil {
+		t.Error(err)
+	}
+	{{else -}}
+	if rowsAff, err := o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Dirty()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+	{{end -}}
+
+	dirty := o.dirtyColumns()
+	{{- if filterColumnsByAuto true .Table.Columns}}
+	dirty = strmangle.SetComplement(dirty, {{$alias.DownSingular}}GeneratedColumns)
+	{{- end}}
+	if len(dirty) != 0 {
+		t.Error("want no changes after update, got:", dirty)
+	}
+
+	untracked, err := Find{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} tx, {{.Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice (printf "%s." "o") | join ", "}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, untracked, {{$alias.DownSingular}}DBTypes, true, {{if $keep}}append([]string{{"{"}}{{$keep}}{{"}"}}, {{$alias.DownSingular}}PrimaryKeyColumns...){{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	// Without a snapshot boil.Dirty updates like boil.Infer
+	{{if .NoRowsAffected -}}
+	if err = untracked.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Dirty()); err != nil {
+		t.Error(err)
+	}
+	{{else -}}
+	if rowsAff, err := untracked.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Dirty()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+	{{end -}}
+	if untracked.tracking != nil {
+		t.Error("want the object to stay untracked")
+	}
 }
 
 func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
@@ -63,7 +183,7 @@ func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
-	{{if not .NoContext}}ctx := context.Background(){{end}}
+	{{if not .NoContext}}ctx := testContext(){{end}}
 	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
 	defer func() { _ = tx.Rollback() }()
 	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
@@ -79,7 +199,7 @@ func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 		t.Error("want one record, got:", count)
 	}
 
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{if $versioned}}append([]string{"{{$versionCol}}"}, {{$alias.DownSingular}}PrimaryKeyColumns...){{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -95,6 +215,9 @@ func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 		{{- if filterColumnsByAuto true .Table.Columns }}
 		fields = strmangle.SetComplement(fields, {{$alias.DownSingular}}GeneratedColumns)
 		{{- end}}
+		{{- if $versioned}}
+		fields = strmangle.SetComplement(fields, []string{"{{$versionCol}}"})
+		{{- end}}
 	}
 
 	value := reflect.Indirect(reflect.ValueOf(o))